@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/password"
+	"golang.org/x/exp/slog"
+)
+
+var (
+	errUnknownCommand = errors.New("unknown command")
+	errUsage          = errors.New("invalid arguments")
+)
+
+func (app *application) command(args []string) error {
+	switch args[0] {
+	case "bootstrap-admin":
+		return app.bootstrapAdmin(args[1:])
+	case "build-password-filter":
+		return buildPasswordFilter(args[1:])
+	case "tokens":
+		return app.tokensCommand(args[1:])
+	case "user":
+		return app.userCommand(args[1:])
+	default:
+		return fmt.Errorf("%w: %s", errUnknownCommand, args[0])
+	}
+}
+
+// bootstrapAdmin grants the admin role to an existing user, so the first admin can be created without the admin API.
+func (app *application) bootstrapAdmin(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: bootstrap-admin <email>", errUsage)
+	}
+
+	if err := logic.GrantUserRole(context.Background(), data.New(app.dbpool), args[0], logic.RoleAdmin); err != nil {
+		return fmt.Errorf("failed grant admin role: %w", err)
+	}
+
+	slog.Info("granted admin role", "email", args[0])
+
+	return nil
+}
+
+// buildPasswordFilter builds a breached password filter offline from a password list, one plaintext password or
+// SHA-1 digest per line, for use with PASSWORD_BREACHED_FILTER.
+func buildPasswordFilter(args []string) error {
+	const falsePositiveRate = 0.001
+
+	if len(args) != 2 { //nolint:gomnd
+		return fmt.Errorf("%w: build-password-filter <passwords> <filter>", errUsage)
+	}
+
+	count, err := countLines(args[0])
+	if err != nil {
+		return err
+	}
+
+	input, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed open passwords: %w", err)
+	}
+	defer input.Close()
+
+	filter, err := password.BuildFilter(input, count, falsePositiveRate)
+	if err != nil {
+		return fmt.Errorf("failed build filter: %w", err)
+	}
+
+	output, err := os.Create(args[1])
+	if err != nil {
+		return fmt.Errorf("failed create filter: %w", err)
+	}
+	defer output.Close()
+
+	size, err := filter.WriteTo(output)
+	if err != nil {
+		return fmt.Errorf("failed write filter: %w", err)
+	}
+
+	slog.Info("built password filter", "passwords", count, "bytes", size)
+
+	return nil
+}
+
+func countLines(path string) (uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed open passwords: %w", err)
+	}
+	defer file.Close()
+
+	var count uint64
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		count++
+	}
+
+	if err = scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed count passwords: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/db"
+	"github.com/seanflannery10/core/internal/shared/health"
+)
+
+var errSchemaBehind = errors.New("database schema is behind")
+
+type healthConfig struct {
+	CheckDatabase   bool          `env:"HEALTH_CHECK_DATABASE,default=true"`
+	CheckMigrations bool          `env:"HEALTH_CHECK_MIGRATIONS,default=true"`
+	CheckSMTP       bool          `env:"HEALTH_CHECK_SMTP,default=false"`
+	Timeout         time.Duration `env:"HEALTH_CHECK_TIMEOUT,default=2s"`
+	DrainDelay      time.Duration `env:"SHUTDOWN_DRAIN_DELAY,default=0s"`
+}
+
+func (app *application) newHealthChecker() *health.Checker {
+	var checks []health.Check
+
+	if app.config.Health.CheckDatabase {
+		checks = append(checks, health.Check{Name: "database", Timeout: app.config.Health.Timeout, Run: app.dbpool.Ping})
+	}
+
+	if app.config.Health.CheckMigrations {
+		checks = append(checks, health.Check{Name: "migrations", Timeout: app.config.Health.Timeout, Run: app.checkMigrations})
+	}
+
+	if app.config.Health.CheckSMTP {
+		checks = append(checks, health.Check{Name: "smtp", Timeout: app.config.Health.Timeout, Run: app.mailer.Ping})
+	}
+
+	return health.New(checks...)
+}
+
+// checkMigrations fails when the database has not been migrated to the newest migration embedded in the binary.
+func (app *application) checkMigrations(ctx context.Context) error {
+	want, err := db.LatestVersion()
+	if err != nil {
+		return fmt.Errorf("failed latest migration version: %w", err)
+	}
+
+	var got string
+
+	err = app.dbpool.QueryRow(ctx, "SELECT coalesce(max(version), '') FROM schema_migrations").Scan(&got)
+	if err != nil {
+		return fmt.Errorf("failed get migration version: %w", err)
+	}
+
+	if got < want {
+		return fmt.Errorf("%w: at %s want %s", errSchemaBehind, got, want)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/server/notify"
+	"github.com/seanflannery10/core/internal/shared/jobs"
+	"golang.org/x/exp/slog"
+)
+
+type (
+	tokenCleanupConfig struct {
+		// Interval between cleanups, zero disables the cleanup.
+		Interval  time.Duration `env:"TOKEN_CLEANUP_INTERVAL,default=1h"`
+		BatchSize int32         `env:"TOKEN_CLEANUP_BATCH_SIZE,default=1000"`
+	}
+	tokenCleanupArgs struct {
+		BatchSize int32 `json:"batch_size"`
+	}
+)
+
+func (tokenCleanupArgs) Kind() string { return "token_cleanup" }
+
+// newWorker registers the job handlers and periodic jobs of the server.
+func (app *application) newWorker() *jobs.Worker {
+	worker := jobs.NewWorker(app.dbpool,
+		jobs.WithConcurrency(app.config.Jobs.Concurrency),
+		jobs.WithPollInterval(app.config.Jobs.PollInterval),
+	)
+
+	jobs.Handle(worker, app.cleanupTokens)
+	jobs.Handle(worker, notify.New(app.mailer, app.keyring).Send)
+	jobs.Handle(worker, logic.HandleRegistration)
+	jobs.Handle(worker, logic.HandleTokenRequest)
+
+	if app.config.TokenCleanup.Interval > 0 {
+		worker.Periodic("token_cleanup", jobs.Every(app.config.TokenCleanup.Interval),
+			tokenCleanupArgs{BatchSize: app.config.TokenCleanup.BatchSize})
+	}
+
+	return worker
+}
+
+// cleanupTokens deletes through the pool rather than the job transaction, so each batch commits on its own and a
+// large backlog doesn't hold its locks until the whole purge is done.
+func (app *application) cleanupTokens(ctx context.Context, _ pgx.Tx, _ *jobs.Job, args tokenCleanupArgs) error {
+	deleted, err := logic.PurgeTokens(ctx, data.New(app.dbpool), time.Now(), args.BatchSize)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	slog.Info("purged tokens", "deleted", deleted)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/seanflannery10/core/internal/shared/logging"
+	"golang.org/x/exp/slog"
+)
+
+type logConfig struct {
+	Level  slog.Level `env:"LOG_LEVEL,default=info"`
+	Format string     `env:"LOG_FORMAT"`
+	Source bool       `env:"LOG_SOURCE,default=false"`
+}
+
+// newLogger builds the default logger, the format defaults to text in dev and json everywhere else.
+func newLogger(cfg *Config, level *slog.LevelVar) (*slog.Logger, error) {
+	format := cfg.Log.Format
+	if format == "" {
+		format = logging.FormatJSON
+
+		if cfg.Env == "dev" {
+			format = logging.FormatText
+		}
+	}
+
+	level.Set(cfg.Log.Level)
+
+	return logging.New(os.Stdout, format, cfg.Log.Source, level) //nolint:wrapcheck
+}
+
+// toggleDebugOnHangup switches between the configured log level and debug each time the process receives SIGHUP.
+func (app *application) toggleDebugOnHangup() {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+
+	for range hangup {
+		if app.logLevel.Level() == slog.LevelDebug {
+			app.logLevel.Set(app.config.Log.Level)
+		} else {
+			app.logLevel.Set(slog.LevelDebug)
+		}
+
+		slog.Info("log level changed", "level", app.logLevel.Level().String())
+	}
+}
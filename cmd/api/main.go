@@ -1,19 +1,24 @@
 package main
 
 import (
+	"flag"
 	"os"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/seanflannery10/core/internal/shared/health"
+	"github.com/seanflannery10/core/internal/shared/keyring"
 	"github.com/seanflannery10/core/internal/shared/mailer"
 	"github.com/seanflannery10/core/internal/shared/server"
 	"golang.org/x/exp/slog"
 )
 
 type application struct {
-	dbpool    *pgxpool.Pool
-	mailer    mailer.Mailer
-	secretKey []byte
-	config    Config
+	dbpool   *pgxpool.Pool
+	health   *health.Checker
+	keyring  *keyring.Keyring
+	logLevel *slog.LevelVar
+	mailer   mailer.Mailer
+	config   Config
 }
 
 func main() {
@@ -21,10 +26,60 @@ func main() {
 
 	app.init()
 
-	if err := server.Serve(app.config.Port, app.routes()); err != nil {
-		slog.Error("unable to serve application", err)
+	// migrate only needs the database, so it runs without a full config and before the mailer and password filter
+	// are set up.
+	if flag.Arg(0) == "migrate" {
+		if err := app.migrate(flag.Args()[1:]); err != nil {
+			slog.Error("unable to run command", "error", err)
+			os.Exit(exitError)
+		}
+
+		return
+	}
+
+	app.setup()
+
+	if flag.NArg() > 0 {
+		err := app.command(flag.Args())
+
+		app.dbpool.Close()
+
+		if err != nil {
+			slog.Error("unable to run command", "error", err)
+			os.Exit(exitError)
+		}
+
+		return
+	}
+
+	if app.config.AutoMigrate {
+		if err := app.autoMigrate(); err != nil {
+			slog.Error("unable to migrate database", "error", err)
+			os.Exit(exitError)
+		}
+	}
+
+	go app.toggleDebugOnHangup()
+
+	worker := app.newWorker()
+	worker.Start()
+
+	opts := []server.Option{
+		server.WithAdminServer(app.config.Admin.Addr, app.adminRoutes()),
+		server.WithShutdownHook(app.health.Shutdown),
+		server.WithDrain(worker.Shutdown),
+		server.WithDrainDelay(app.config.Health.DrainDelay),
+		server.WithTimeouts(app.config.Server),
+	}
+
+	if err := server.Serve(app.config.Port, app.routes(), opts...); err != nil {
+		slog.Error("unable to serve application", "error", err)
 		os.Exit(exitError)
 	}
 
+	if err := app.mailer.Close(); err != nil {
+		slog.Error("unable to close mailer", "error", err)
+	}
+
 	app.dbpool.Close()
 }
@@ -1,14 +1,28 @@
 package main
 
 import (
+	crand "crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"math/rand"
+	"net"
 	"net/http"
+	"net/netip"
 	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ogen-go/ogen/middleware"
+	"github.com/seanflannery10/core/internal/generated/api"
 	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/metrics"
+	"github.com/seanflannery10/core/internal/shared/utils"
 	"golang.org/x/exp/slog"
 )
 
+const headerRequestID = "X-Request-ID"
+
 func (app *application) RecoverPanic() middleware.Middleware {
 	return func(req middleware.Request, next func(req middleware.Request) (middleware.Response, error)) (middleware.Response, error) {
 		recovered := false
@@ -34,3 +48,215 @@ func (app *application) RecoverPanic() middleware.Middleware {
 		return next(req)
 	}
 }
+
+// ClientInfo records the IP, user agent and languages of the client. The IP is the peer address, or the client IP
+// header when the peer is a trusted proxy.
+func (app *application) ClientInfo() middleware.Middleware {
+	return func(req middleware.Request, next func(req middleware.Request) (middleware.Response, error)) (middleware.Response, error) {
+		ip := clientIP(req.Raw, app.config.Proxy)
+
+		req.Context = utils.ContextSetClient(req.Context, utils.Client{IPAddress: ip, UserAgent: req.Raw.UserAgent(), AcceptLanguage: req.Raw.Header.Get("Accept-Language")})
+
+		return next(req)
+	}
+}
+
+func clientIP(r *http.Request, proxy proxyConfig) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	peer, err := netip.ParseAddr(host)
+	if err != nil || !proxy.TrustedProxies.contains(peer) {
+		return host
+	}
+
+	if ip, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get(proxy.ClientIPHeader))); err == nil {
+		return ip.String()
+	}
+
+	return host
+}
+
+// adminAuth requires basic auth or a bearer token on the admin listener when either is configured.
+func (app *application) adminAuth(next http.Handler) http.Handler {
+	username, password, token := app.config.Admin.Username, app.config.Admin.Password, app.config.Admin.Token
+
+	if password == "" && token == "" {
+		return next
+	}
+
+	return requireAuth("admin", username, password, token, next)
+}
+
+// webhookAuth requires the basic auth credentials of the email provider webhooks, which always have a password.
+func (app *application) webhookAuth(next http.Handler) http.Handler {
+	return requireAuth("webhook", app.config.Webhook.Username, app.config.Webhook.Password, "", next)
+}
+
+// requireAuth lets requests through with the basic auth credentials or the bearer token, an empty password or token
+// is never accepted.
+func requireAuth(realm, username, password, token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); ok && password != "" {
+			if secureCompare(user, username) && secureCompare(pass, password) {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		if authorization := r.Header.Get("Authorization"); strings.HasPrefix(authorization, "Bearer ") && token != "" {
+			if secureCompare(strings.TrimPrefix(authorization, "Bearer "), token) {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		if password != "" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="`+realm+`", charset="UTF-8"`)
+		}
+
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+	})
+}
+
+func secureCompare(given, want string) bool {
+	return subtle.ConstantTimeCompare([]byte(given), []byte(want)) == 1
+}
+
+// statusRecorder captures the status code and size of a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if r.status == 0 {
+		r.status = code
+	}
+
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+
+	return n, err //nolint:wrapcheck
+}
+
+// operationName returns the name of the ogen route serving r, requests that match no route share a single name so
+// the number of metric series stays bounded.
+func operationName(srv *api.Server, r *http.Request) string {
+	route, ok := srv.FindRoute(r.Method, r.URL.Path)
+	if !ok {
+		return "unknown"
+	}
+
+	return route.Name()
+}
+
+// instrument records the RED metrics of every API request, including requests rejected before reaching a handler.
+func (app *application) instrument(srv *api.Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+
+		srv.ServeHTTP(rec, r)
+
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+
+		operation := operationName(srv, r)
+
+		metrics.RequestsTotal.WithLabelValues(operation, strconv.Itoa(rec.status)).Inc()
+		metrics.RequestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	})
+}
+
+// requestContext adds a Request to the context of every request, the request ID is taken from the X-Request-ID
+// header when the client sent a valid one and generated otherwise. The ID is echoed in the response headers.
+func (app *application) requestContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(headerRequestID)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+
+		w.Header().Set(headerRequestID, id)
+
+		ctx := utils.ContextSetRequest(r.Context(), &utils.Request{ID: id})
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// accessLog logs one line per request, successful requests are sampled at the configured rate while server errors
+// are always logged.
+func (app *application) accessLog(srv *api.Server, next http.Handler) http.Handler {
+	sampleRate, level := app.config.AccessLog.SampleRate, app.config.AccessLog.Level
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+
+		next.ServeHTTP(rec, r)
+
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+
+		if rec.status < http.StatusInternalServerError && rand.Float64() >= sampleRate { //nolint:gosec
+			return
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("operation", operationName(srv, r)),
+			slog.Int("status", rec.status),
+			slog.Duration("latency", time.Since(start)),
+			slog.Int("bytes", rec.bytes),
+		}
+
+		if request := utils.ContextGetRequest(r.Context()); request != nil && request.UserID != 0 {
+			attrs = append(attrs, slog.Int64("user_id", request.UserID))
+		}
+
+		slog.LogAttrs(r.Context(), level, "request", attrs...)
+	})
+}
+
+func validRequestID(id string) bool {
+	const maxRequestIDLength = 128
+
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for _, c := range id {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newRequestID() string {
+	const lengthRandom = 16
+	randomBytes := make([]byte, lengthRandom)
+
+	if _, err := crand.Read(randomBytes); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36) //nolint:gomnd
+	}
+
+	return hex.EncodeToString(randomBytes)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/seanflannery10/core/db"
+	"github.com/seanflannery10/core/internal/shared/migrate"
+	"golang.org/x/exp/slog"
+)
+
+// migrationsDir is where migrate new writes, relative to the repository root.
+const migrationsDir = "db/migrations"
+
+func (app *application) migrate(args []string) error {
+	const usage = "migrate up|down|status|new <name>"
+
+	if len(args) == 0 {
+		return fmt.Errorf("%w: %s", errUsage, usage)
+	}
+
+	if args[0] == "new" {
+		if len(args) != 2 { //nolint:gomnd
+			return fmt.Errorf("%w: migrate new <name>", errUsage)
+		}
+
+		path, err := migrate.Create(migrationsDir, args[1], time.Now())
+		if err != nil {
+			return fmt.Errorf("failed create migration: %w", err)
+		}
+
+		slog.Info("created migration", "path", path)
+
+		return nil
+	}
+
+	dbpool, err := pgxpool.New(context.Background(), app.config.DatabaseURL)
+	if err != nil {
+		return fmt.Errorf("failed create connection pool: %w", err)
+	}
+	defer dbpool.Close()
+
+	migrator, err := migrate.New(dbpool, db.MigrationFiles())
+	if err != nil {
+		return fmt.Errorf("failed load migrations: %w", err)
+	}
+
+	switch args[0] {
+	case "up":
+		return app.migrateUp(context.Background(), migrator)
+	case "down":
+		if _, err = migrator.Down(context.Background()); err != nil {
+			return fmt.Errorf("failed migrate down: %w", err)
+		}
+
+		return nil
+	case "status":
+		return printMigrationStatus(context.Background(), migrator)
+	default:
+		return fmt.Errorf("%w: %s", errUsage, usage)
+	}
+}
+
+// autoMigrate applies pending migrations before the server starts when AUTO_MIGRATE is set.
+func (app *application) autoMigrate() error {
+	migrator, err := migrate.New(app.dbpool, db.MigrationFiles())
+	if err != nil {
+		return fmt.Errorf("failed load migrations: %w", err)
+	}
+
+	return app.migrateUp(context.Background(), migrator)
+}
+
+func (app *application) migrateUp(ctx context.Context, migrator *migrate.Migrator) error {
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		return fmt.Errorf("failed migrate up: %w", err)
+	}
+
+	slog.Info("database migrated", "applied", len(applied))
+
+	return nil
+}
+
+func printMigrationStatus(ctx context.Context, migrator *migrate.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed migration status: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:gomnd
+
+	_, _ = fmt.Fprintln(w, "VERSION\tNAME\tSTATUS")
+
+	for _, s := range statuses {
+		status := "pending"
+		if s.Applied {
+			status = "applied"
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", s.Version, s.Name, status)
+	}
+
+	return w.Flush() //nolint:wrapcheck
+}
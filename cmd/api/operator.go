@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/locale"
+	"github.com/seanflannery10/core/internal/shared/utils"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+)
+
+type (
+	// operation changes the database through q, it runs in a transaction that is rolled back on a dry run.
+	operation func(ctx context.Context, q *data.Queries) (*operationResult, error)
+	// operationResult is printed once the operation is done, commands without a table print JSON.
+	operationResult struct {
+		value any
+		table [][]string
+	}
+	operatorFlags struct {
+		*flag.FlagSet
+		dryRun *bool
+		output *string
+	}
+)
+
+func newOperatorFlags(name string) *operatorFlags {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+
+	return &operatorFlags{
+		FlagSet: fs,
+		dryRun:  fs.Bool("dry-run", false, "Roll back all changes and send no email"),
+		output:  fs.String("output", outputTable, "Output format, json or table"),
+	}
+}
+
+func (f *operatorFlags) parse(args []string) error {
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("%w: %v", errUsage, err) //nolint:errorlint
+	}
+
+	if *f.output != outputJSON && *f.output != outputTable {
+		return fmt.Errorf("%w: -output must be %s or %s", errUsage, outputJSON, outputTable)
+	}
+
+	return nil
+}
+
+func (app *application) userCommand(args []string) error {
+	const usage = "user create|activate|deactivate|reset-password|resend-activation|export [-dry-run] [-output json|table] ..."
+
+	if len(args) == 0 {
+		return fmt.Errorf("%w: %s", errUsage, usage)
+	}
+
+	action := args[0]
+	flags := newOperatorFlags("user " + action)
+	activate := flags.Bool("activate", false, "With create, activate the user instead of sending an activation email")
+	userLocale := flags.String("locale", locale.Default, "With create, the BCP 47 language tag of the user's emails")
+
+	if err := flags.parse(args[1:]); err != nil {
+		return err
+	}
+
+	var op operation
+
+	switch {
+	case action == "create" && flags.NArg() == 2: //nolint:gomnd
+		pass, err := readPassword(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		op = createUser(logic.NewUserParams{Name: flags.Arg(0), Email: flags.Arg(1), Password: pass, Locale: *userLocale}, *activate)
+	case action == "activate" && flags.NArg() == 1:
+		op = userOperation(flags.Arg(0), activateUser)
+	case action == "deactivate" && flags.NArg() == 1:
+		op = userOperation(flags.Arg(0), deactivateUser)
+	case action == "reset-password" && flags.NArg() == 1:
+		op = userOperation(flags.Arg(0), resetPassword)
+	case action == "resend-activation" && flags.NArg() == 1:
+		op = userOperation(flags.Arg(0), resendActivation)
+	case action == "export" && flags.NArg() == 1:
+		op = userOperation(flags.Arg(0), exportUser)
+	case action == "create":
+		return fmt.Errorf("%w: user create [-activate] [-locale tag] <name> <email> < password", errUsage)
+	default:
+		return fmt.Errorf("%w: %s", errUsage, usage)
+	}
+
+	return app.runOperation(*flags.dryRun, *flags.output, op)
+}
+
+func (app *application) tokensCommand(args []string) error {
+	const usage = "tokens purge [-dry-run] [-output json|table]"
+
+	if len(args) == 0 || args[0] != "purge" {
+		return fmt.Errorf("%w: %s", errUsage, usage)
+	}
+
+	flags := newOperatorFlags("tokens purge")
+
+	if err := flags.parse(args[1:]); err != nil {
+		return err
+	}
+
+	return app.runOperation(*flags.dryRun, *flags.output, func(ctx context.Context, q *data.Queries) (*operationResult, error) {
+		deleted, err := logic.PurgeTokens(ctx, q, time.Now(), app.config.TokenCleanup.BatchSize)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		scopes := maps.Keys(deleted)
+		sort.Strings(scopes)
+
+		table := [][]string{{"SCOPE", "DELETED"}}
+		for _, scope := range scopes {
+			table = append(table, []string{scope, strconv.FormatInt(deleted[scope], 10)})
+		}
+
+		return &operationResult{value: deleted, table: table}, nil
+	})
+}
+
+// runOperation runs op in a transaction, commits it unless dryRun is set and then prints its result. Emails are
+// enqueued in the transaction and sent by the server's job worker, so a dry run sends none. Audit events record the CLI as the user agent.
+func (app *application) runOperation(dryRun bool, output string, op operation) error {
+	ctx := utils.ContextSetClient(context.Background(), utils.Client{UserAgent: "core-cli/" + utils.GetVersion()})
+
+	tx, err := app.dbpool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed begin transaction: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	result, err := op(ctx, data.New(tx))
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		slog.Info("dry run, changes rolled back")
+	} else if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed commit transaction: %w", err)
+	}
+
+	return printResult(os.Stdout, output, result)
+}
+
+func printResult(w io.Writer, output string, result *operationResult) error {
+	if output == outputTable && result.table != nil {
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint:gomnd
+
+		for _, row := range result.table {
+			_, _ = fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+
+		return tw.Flush() //nolint:wrapcheck
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result.value) //nolint:wrapcheck
+}
+
+// userOperation looks up the user by email before running fn, so every user command can take an email address.
+func userOperation(email string, fn func(context.Context, *data.Queries, *api.AdminUserResponse) (*operationResult, error)) operation {
+	return func(ctx context.Context, q *data.Queries) (*operationResult, error) {
+		user, err := logic.AdminGetUserFromEmail(ctx, q, email)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		return fn(ctx, q, user)
+	}
+}
+
+func createUser(params logic.NewUserParams, activate bool) operation {
+	return func(ctx context.Context, q *data.Queries) (*operationResult, error) {
+		user, err := logic.AdminNewUser(ctx, q, params, activate)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		return &operationResult{value: user, table: userTable(*user)}, nil
+	}
+}
+
+func activateUser(ctx context.Context, q *data.Queries, target *api.AdminUserResponse) (*operationResult, error) {
+	user, err := logic.AdminReactivateUser(ctx, q, target.ID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &operationResult{value: user, table: userTable(*user)}, nil
+}
+
+func deactivateUser(ctx context.Context, q *data.Queries, target *api.AdminUserResponse) (*operationResult, error) {
+	user, err := logic.AdminDeactivateUser(ctx, q, target.ID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &operationResult{value: user, table: userTable(*user)}, nil
+}
+
+func resetPassword(ctx context.Context, q *data.Queries, target *api.AdminUserResponse) (*operationResult, error) {
+	user, err := logic.AdminForcePasswordReset(ctx, q, target.ID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &operationResult{value: user, table: userTable(*user)}, nil
+}
+
+func resendActivation(ctx context.Context, q *data.Queries, target *api.AdminUserResponse) (*operationResult, error) {
+	user, err := logic.AdminResendActivationToken(ctx, q, target.ID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &operationResult{value: user, table: userTable(*user)}, nil
+}
+
+func exportUser(ctx context.Context, q *data.Queries, target *api.AdminUserResponse) (*operationResult, error) {
+	export, err := logic.AdminExportUser(ctx, q, target.ID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &operationResult{value: export}, nil
+}
+
+func userTable(users ...api.AdminUserResponse) [][]string {
+	table := [][]string{{"ID", "NAME", "EMAIL", "ACTIVATED", "DISABLED", "CREATED"}}
+
+	for _, u := range users {
+		table = append(table, []string{
+			strconv.FormatInt(u.ID, 10),
+			u.Name,
+			u.Email,
+			strconv.FormatBool(u.Activated),
+			strconv.FormatBool(u.Disabled),
+			u.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return table
+}
+
+// readPassword reads the first line of r, so passwords never show up in the process list or shell history.
+func readPassword(r io.Reader) (string, error) {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed read password: %w", err)
+	}
+
+	pass := strings.TrimRight(line, "\r\n")
+	if pass == "" {
+		return "", fmt.Errorf("%w: password must be given on stdin", errUsage)
+	}
+
+	return pass, nil
+}
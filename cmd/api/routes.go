@@ -9,31 +9,49 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/seanflannery10/core/internal/generated/api"
 	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/bounce"
 	"github.com/seanflannery10/core/internal/server/handler"
+	"github.com/seanflannery10/core/internal/shared/logging"
 	"golang.org/x/exp/slog"
 )
 
-func (app *application) routes() *http.ServeMux {
+func (app *application) routes() http.Handler {
 	newHandler := &handler.Handler{
-		Queries: data.New(app.dbpool),
-		Mailer:  app.mailer,
-		Secret:  app.secretKey,
+		Queries:   data.New(app.dbpool),
+		Pool:      app.dbpool,
+		Keyring:   app.keyring,
+		CookieTTL: app.config.Tokens.Refresh,
 	}
 
 	srv, err := api.NewServer(
 		newHandler,
-		&security{Queries: data.New(app.dbpool), SecretKey: app.secretKey},
-		api.WithMiddleware(app.RecoverPanic()),
+		&security{Queries: data.New(app.dbpool), Keyring: app.keyring},
+		api.WithMiddleware(app.RecoverPanic(), app.ClientInfo()),
 		api.WithErrorHandler(handler.ErrorHandler),
 	)
 	if err != nil {
-		slog.Error("unable to create new server", err)
+		slog.Error("unable to create new server", "error", err)
 		os.Exit(exitError) //nolint:revive
 	}
 
 	mux := http.NewServeMux()
 
-	mux.Handle("/", srv)
+	mux.Handle("/", app.accessLog(srv, app.instrument(srv)))
+
+	// Probes hit these every few seconds, they are left out of the access log.
+	mux.HandleFunc("/healthz", app.health.Liveness)
+	mux.HandleFunc("/readyz", app.health.Readiness)
+
+	if app.config.Webhook.Password != "" {
+		mux.Handle("/v1/webhooks/email", app.accessLog(srv, app.webhookAuth(bounce.Handler(app.dbpool))))
+	}
+
+	return app.requestContext(mux)
+}
+
+// adminRoutes serves the diagnostics endpoints, they are only mounted on the admin listener.
+func (app *application) adminRoutes() http.Handler {
+	mux := http.NewServeMux()
 
 	mux.Handle("/metrics", promhttp.Handler())
 
@@ -46,5 +64,7 @@ func (app *application) routes() *http.ServeMux {
 
 	mux.HandleFunc("/debug/vars", expvar.Handler().ServeHTTP)
 
-	return mux
+	mux.Handle("/log-level", logging.LevelHandler(app.logLevel))
+
+	return app.adminAuth(mux)
 }
@@ -2,26 +2,24 @@ package main
 
 import (
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
 	"crypto/sha256"
-	"strings"
 	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/seanflannery10/core/internal/generated/api"
 	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/handler"
 	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/keyring"
 	"github.com/seanflannery10/core/internal/shared/utils"
-	"github.com/segmentio/asm/base64"
 )
 
 type security struct {
-	Queries   *data.Queries
-	SecretKey []byte
+	Queries *data.Queries
+	Keyring *keyring.Keyring
 }
 
-func (s *security) HandleAccess(ctx context.Context, _ string, t api.Access) (context.Context, error) {
+func (s *security) HandleAccess(ctx context.Context, operationName string, t api.Access) (context.Context, error) {
 	tokenHash := sha256.Sum256([]byte(t.Token))
 
 	user, err := s.Queries.GetUserFromToken(ctx, data.GetUserFromTokenParams{
@@ -33,46 +31,64 @@ func (s *security) HandleAccess(ctx context.Context, _ string, t api.Access) (co
 		return ctx, logic.ErrInvalidAccessToken
 	}
 
+	if user.Disabled {
+		return ctx, logic.ErrUserDisabled
+	}
+
 	if !user.Activated {
 		return ctx, logic.ErrActivationRequired
 	}
 
+	if err = logic.CheckUserPermission(ctx, s.Queries, operationName, user.ID); err != nil {
+		return ctx, err
+	}
+
 	return utils.ContextSetUser(ctx, user), nil
 }
 
-func (s *security) HandleRefresh(ctx context.Context, _ string, r api.Refresh) (context.Context, error) {
-	encryptedValue, err := base64.URLEncoding.DecodeString(r.APIKey)
-	if err != nil {
-		return ctx, errors.Wrap(err, "failed decode string")
-	}
+func (s *security) HandleAPIKey(ctx context.Context, operationName string, k api.APIKey) (context.Context, error) {
+	keyHash := sha256.Sum256([]byte(k.APIKey))
 
-	block, err := aes.NewCipher(s.SecretKey)
+	row, err := s.Queries.GetUserFromAPIKey(ctx, data.GetUserFromAPIKeyParams{Hash: keyHash[:], Now: time.Now()})
 	if err != nil {
-		return ctx, errors.Wrap(err, "failed cipher")
+		return ctx, logic.ErrInvalidAPIKey
 	}
 
-	aesGCM, err := cipher.NewGCM(block)
-	if err != nil {
-		return ctx, errors.Wrap(err, "failed new gcm")
+	if row.Disabled {
+		return ctx, logic.ErrUserDisabled
 	}
 
-	nonceSize := aesGCM.NonceSize()
+	if !row.Activated {
+		return ctx, logic.ErrActivationRequired
+	}
 
-	if len(encryptedValue) < nonceSize {
-		return ctx, nil
+	if err = logic.CheckAPIKeyPermission(operationName, row.Permissions); err != nil {
+		return ctx, err
 	}
 
-	nonce := encryptedValue[:nonceSize]
-	ciphertext := encryptedValue[nonceSize:]
+	if err = s.Queries.UpdateAPIKeyLastUsed(ctx, data.UpdateAPIKeyLastUsedParams{LastUsedAt: time.Now(), ID: row.ApiKeyID}); err != nil {
+		return ctx, errors.Wrap(err, "failed update api key last used")
+	}
 
-	plaintext, err := aesGCM.Open(nil, nonce, ciphertext, nil)
-	if err != nil {
-		return ctx, errors.Wrap(err, "failed gcm open")
+	user := &data.User{
+		ID:           row.ID,
+		CreatedAt:    row.CreatedAt,
+		Name:         row.Name,
+		Email:        row.Email,
+		PasswordHash: row.PasswordHash,
+		Activated:    row.Activated,
+		Version:      row.Version,
+		Locale:       row.Locale,
+		Disabled:     row.Disabled,
 	}
 
-	_, value, ok := strings.Cut(string(plaintext), ":")
-	if !ok {
-		return ctx, errors.Wrap(err, "failed cut string")
+	return utils.ContextSetUser(ctx, user), nil
+}
+
+func (s *security) HandleRefresh(ctx context.Context, _ string, r api.Refresh) (context.Context, error) {
+	value, err := s.Keyring.Open(handler.CookieRefreshToken, r.APIKey)
+	if err != nil {
+		return ctx, errors.Wrap(err, "failed open refresh token cookie")
 	}
 
 	return utils.ContextSetCookieValue(ctx, value), nil
@@ -0,0 +1,52 @@
+// Package db embeds the database migrations so the binary knows which schema version it expects.
+package db
+
+import (
+	"embed"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+var ErrNoMigrations = errors.New("no migrations found")
+
+// MigrationFiles returns the embedded migrations directory as the root of a file system.
+func MigrationFiles() fs.FS {
+	sub, err := fs.Sub(Migrations, "migrations")
+	if err != nil {
+		panic(err) // unreachable, "migrations" is a valid path
+	}
+
+	return sub
+}
+
+// LatestVersion returns the version of the newest migration, the timestamp prefix of its file name as recorded by
+// dbmate in the schema_migrations table.
+func LatestVersion() (string, error) {
+	entries, err := fs.ReadDir(Migrations, "migrations")
+	if err != nil {
+		return "", errors.Wrap(err, "failed read migrations")
+	}
+
+	versions := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		version, _, ok := strings.Cut(entry.Name(), "_")
+		if ok {
+			versions = append(versions, version)
+		}
+	}
+
+	if len(versions) == 0 {
+		return "", ErrNoMigrations
+	}
+
+	sort.Strings(versions)
+
+	return versions[len(versions)-1], nil
+}
@@ -0,0 +1,15 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id           bigserial PRIMARY KEY,
+    created_at   timestamp(0) NOT NULL DEFAULT now(),
+    name         text         NOT NULL,
+    hash         bytea UNIQUE NOT NULL,
+    permissions  text[]       NOT NULL,
+    expiry       timestamp(0),
+    last_used_at timestamp(0),
+    user_id      bigint       NOT NULL REFERENCES users ON DELETE CASCADE
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,45 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS roles
+(
+    id   bigserial PRIMARY KEY,
+    name text UNIQUE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS permissions
+(
+    id   bigserial PRIMARY KEY,
+    code text UNIQUE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS roles_permissions
+(
+    role_id       bigint NOT NULL REFERENCES roles ON DELETE CASCADE,
+    permission_id bigint NOT NULL REFERENCES permissions ON DELETE CASCADE,
+    PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE TABLE IF NOT EXISTS users_roles
+(
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    role_id bigint NOT NULL REFERENCES roles ON DELETE CASCADE,
+    PRIMARY KEY (user_id, role_id)
+);
+
+INSERT INTO roles (name)
+VALUES ('admin');
+
+INSERT INTO permissions (code)
+VALUES ('admin:users:read'),
+       ('admin:users:write');
+
+INSERT INTO roles_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles,
+     permissions
+WHERE roles.name = 'admin';
+
+-- migrate:down
+DROP TABLE IF EXISTS users_roles;
+DROP TABLE IF EXISTS roles_permissions;
+DROP TABLE IF EXISTS permissions;
+DROP TABLE IF EXISTS roles;
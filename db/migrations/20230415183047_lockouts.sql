@@ -0,0 +1,22 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS lockouts
+(
+    user_id       bigint PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+    failed_logins integer NOT NULL DEFAULT 0,
+    lockouts      integer NOT NULL DEFAULT 0,
+    locked_until  timestamp(0)
+);
+
+CREATE TABLE IF NOT EXISTS known_devices
+(
+    user_id    bigint       NOT NULL REFERENCES users ON DELETE CASCADE,
+    ip_address text         NOT NULL,
+    user_agent text         NOT NULL,
+    first_seen timestamp(0) NOT NULL DEFAULT now(),
+    last_seen  timestamp(0) NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, ip_address, user_agent)
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS known_devices;
+DROP TABLE IF EXISTS lockouts;
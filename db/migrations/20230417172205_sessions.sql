@@ -0,0 +1,20 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS sessions
+(
+    id           bigserial PRIMARY KEY,
+    created_at   timestamp(0) NOT NULL DEFAULT now(),
+    last_used_at timestamp(0) NOT NULL DEFAULT now(),
+    ip_address   text         NOT NULL,
+    user_agent   text         NOT NULL,
+    revoked      bool         NOT NULL DEFAULT false,
+    user_id      bigint       NOT NULL REFERENCES users ON DELETE CASCADE
+);
+
+ALTER TABLE tokens
+    ADD COLUMN IF NOT EXISTS session_id bigint REFERENCES sessions ON DELETE CASCADE;
+
+-- migrate:down
+ALTER TABLE tokens
+    DROP COLUMN IF EXISTS session_id;
+
+DROP TABLE IF EXISTS sessions;
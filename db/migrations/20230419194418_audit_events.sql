@@ -0,0 +1,45 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id         bigserial PRIMARY KEY,
+    created_at timestamp(0) NOT NULL DEFAULT now(),
+    event_type text         NOT NULL,
+    user_id    bigint,
+    actor_id   bigint,
+    ip_address text         NOT NULL,
+    user_agent text         NOT NULL,
+    details    jsonb        NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_user_id_idx ON audit_events (user_id);
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE
+    ON audit_events
+    FOR EACH ROW
+EXECUTE FUNCTION audit_events_append_only();
+
+INSERT INTO permissions (code)
+VALUES ('admin:audit:read');
+
+INSERT INTO roles_permissions (role_id, permission_id)
+SELECT roles.id, permissions.id
+FROM roles,
+     permissions
+WHERE roles.name = 'admin'
+  AND permissions.code = 'admin:audit:read';
+
+-- migrate:down
+DELETE
+FROM permissions
+WHERE code = 'admin:audit:read';
+
+DROP TABLE IF EXISTS audit_events;
+DROP FUNCTION IF EXISTS audit_events_append_only();
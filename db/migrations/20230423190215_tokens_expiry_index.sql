@@ -0,0 +1,5 @@
+-- migrate:up transaction:false
+CREATE INDEX CONCURRENTLY IF NOT EXISTS tokens_expiry_idx ON tokens (expiry);
+
+-- migrate:down transaction:false
+DROP INDEX CONCURRENTLY IF EXISTS tokens_expiry_idx;
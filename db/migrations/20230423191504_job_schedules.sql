@@ -0,0 +1,9 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS job_schedules
+(
+    name        text PRIMARY KEY,
+    next_run_at timestamp NOT NULL
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS job_schedules;
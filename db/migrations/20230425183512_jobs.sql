@@ -0,0 +1,20 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS jobs
+(
+    id           bigserial PRIMARY KEY,
+    created_at   timestamp(0) NOT NULL DEFAULT now(),
+    kind         text         NOT NULL,
+    args         jsonb        NOT NULL DEFAULT '{}',
+    state        text         NOT NULL DEFAULT 'pending',
+    unique_key   text,
+    run_at       timestamp    NOT NULL DEFAULT now(),
+    attempts     integer      NOT NULL DEFAULT 0,
+    max_attempts integer      NOT NULL DEFAULT 5,
+    last_error   text
+);
+
+CREATE INDEX IF NOT EXISTS jobs_pending_idx ON jobs (run_at) WHERE state = 'pending';
+CREATE UNIQUE INDEX IF NOT EXISTS jobs_unique_key_idx ON jobs (kind, unique_key) WHERE state = 'pending';
+
+-- migrate:down
+DROP TABLE IF EXISTS jobs;
@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS locale text NOT NULL DEFAULT 'en';
+
+-- migrate:down
+ALTER TABLE users
+    DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,12 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS suppressed_emails
+(
+    email      citext PRIMARY KEY,
+    created_at timestamp(0) NOT NULL DEFAULT now(),
+    reason     text         NOT NULL,
+    source     text         NOT NULL,
+    detail     text         NOT NULL DEFAULT ''
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS suppressed_emails;
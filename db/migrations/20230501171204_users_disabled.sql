@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS disabled bool NOT NULL DEFAULT false;
+
+-- migrate:down
+ALTER TABLE users
+    DROP COLUMN IF EXISTS disabled;
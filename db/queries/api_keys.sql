@@ -0,0 +1,40 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (name, hash, permissions, expiry, user_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetUserAPIKeys :many
+SELECT id, created_at, name, hash, permissions, expiry, last_used_at, user_id
+FROM api_keys
+WHERE user_id = $1
+ORDER BY id;
+
+-- name: DeleteAPIKey :one
+DELETE
+FROM api_keys
+WHERE id = $1
+  AND user_id = $2
+RETURNING *;
+
+-- name: GetUserFromAPIKey :one
+SELECT users.id,
+       users.created_at,
+       users.name,
+       users.email,
+       users.password_hash,
+       users.activated,
+       users.version,
+       users.locale,
+       users.disabled,
+       api_keys.id AS api_key_id,
+       api_keys.permissions
+FROM users
+         INNER JOIN api_keys
+                    ON users.id = api_keys.user_id
+WHERE api_keys.hash = @hash
+  AND (api_keys.expiry IS NULL OR api_keys.expiry > @now::timestamp);
+
+-- name: UpdateAPIKeyLastUsed :exec
+UPDATE api_keys
+SET last_used_at = @last_used_at::timestamp
+WHERE id = @id;
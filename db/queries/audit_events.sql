@@ -0,0 +1,27 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (event_type, user_id, actor_id, ip_address, user_agent, details)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetAuditEvents :many
+SELECT id, created_at, event_type, user_id, actor_id, ip_address, user_agent, details
+FROM audit_events
+WHERE (sqlc.narg('user_id')::bigint IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (sqlc.narg('event_type')::text IS NULL OR event_type = sqlc.narg('event_type'))
+  AND (sqlc.narg('since')::timestamp IS NULL OR created_at >= sqlc.narg('since'))
+  AND (sqlc.narg('until')::timestamp IS NULL OR created_at < sqlc.narg('until'))
+ORDER BY id DESC
+OFFSET sqlc.arg('offset') LIMIT sqlc.arg('limit');
+
+-- name: GetAuditEventCount :one
+SELECT count(1)
+FROM audit_events
+WHERE (sqlc.narg('user_id')::bigint IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (sqlc.narg('event_type')::text IS NULL OR event_type = sqlc.narg('event_type'))
+  AND (sqlc.narg('since')::timestamp IS NULL OR created_at >= sqlc.narg('since'))
+  AND (sqlc.narg('until')::timestamp IS NULL OR created_at < sqlc.narg('until'));
+
+-- name: ExportUserAuditEvents :many
+SELECT id, created_at, event_type, user_id, actor_id, ip_address, user_agent, details
+FROM audit_events
+WHERE user_id = $1
+ORDER BY id;
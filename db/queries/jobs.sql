@@ -0,0 +1,19 @@
+-- name: EnqueueJob :one
+INSERT INTO jobs (kind, args, unique_key, run_at, max_attempts)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (kind, unique_key) WHERE state = 'pending' DO NOTHING
+RETURNING id;
+
+-- name: TryLockSchedule :one
+SELECT pg_try_advisory_xact_lock(hashtext(@name::text))::bool;
+
+-- name: InsertJobSchedule :exec
+INSERT INTO job_schedules (name, next_run_at)
+VALUES (@name, @next_run_at)
+ON CONFLICT DO NOTHING;
+
+-- name: ClaimJobSchedule :execrows
+UPDATE job_schedules
+SET next_run_at = @next_run_at
+WHERE name = @name
+  AND next_run_at <= @now::timestamp;
@@ -0,0 +1,33 @@
+-- name: GetLockout :one
+SELECT user_id, failed_logins, lockouts, locked_until
+FROM lockouts
+WHERE user_id = $1;
+
+-- name: RecordFailedLogin :one
+INSERT INTO lockouts (user_id, failed_logins)
+VALUES ($1, 1)
+ON CONFLICT (user_id) DO UPDATE
+    SET failed_logins = lockouts.failed_logins + 1
+RETURNING *;
+
+-- name: LockUser :exec
+UPDATE lockouts
+SET failed_logins = 0,
+    lockouts      = lockouts + 1,
+    locked_until  = @locked_until::timestamp
+WHERE user_id = @user_id;
+
+-- name: DeleteLockout :exec
+DELETE
+FROM lockouts
+WHERE user_id = $1;
+
+-- name: CheckKnownDevices :one
+SELECT EXISTS(SELECT 1 FROM known_devices WHERE user_id = $1)::bool;
+
+-- name: UpsertKnownDevice :one
+INSERT INTO known_devices (user_id, ip_address, user_agent)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, ip_address, user_agent) DO UPDATE
+    SET last_seen = now()
+RETURNING (xmax = 0)::bool AS inserted;
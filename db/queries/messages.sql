@@ -39,3 +39,13 @@ OFFSET $2 LIMIT $3;
 SELECT count(1)
 FROM messages
 WHERE user_id = $1;
+
+-- name: ExportUserMessages :many
+SELECT id,
+       created_at,
+       message,
+       user_id,
+       version
+FROM messages
+WHERE user_id = $1
+ORDER BY id;
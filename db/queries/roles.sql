@@ -0,0 +1,18 @@
+-- name: GetUserPermissions :many
+SELECT DISTINCT permissions.code
+FROM permissions
+         INNER JOIN roles_permissions
+                    ON permissions.id = roles_permissions.permission_id
+         INNER JOIN users_roles
+                    ON roles_permissions.role_id = users_roles.role_id
+WHERE users_roles.user_id = $1;
+
+-- name: GrantUserRole :exec
+INSERT INTO users_roles (user_id, role_id)
+SELECT $1, roles.id
+FROM roles
+WHERE roles.name = $2
+ON CONFLICT DO NOTHING;
+
+-- name: CheckRole :one
+SELECT EXISTS(SELECT id FROM roles WHERE name = $1)::bool;
@@ -0,0 +1,48 @@
+-- name: CreateSession :one
+INSERT INTO sessions (ip_address, user_agent, user_id)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetSession :one
+SELECT id, created_at, last_used_at, ip_address, user_agent, revoked, user_id
+FROM sessions
+WHERE id = $1;
+
+-- name: GetUserSessions :many
+SELECT sessions.id,
+       sessions.created_at,
+       sessions.last_used_at,
+       sessions.ip_address,
+       sessions.user_agent,
+       sessions.revoked,
+       sessions.user_id
+FROM sessions
+WHERE sessions.user_id = @user_id
+  AND sessions.revoked = false
+  AND EXISTS(SELECT 1
+             FROM tokens
+             WHERE tokens.session_id = sessions.id
+               AND tokens.scope = 'refresh'
+               AND tokens.active = true
+               AND tokens.expiry > @now::timestamp)
+ORDER BY sessions.last_used_at DESC;
+
+-- name: TouchSession :exec
+UPDATE sessions
+SET last_used_at = now(),
+    ip_address   = $1,
+    user_agent   = $2
+WHERE id = $3;
+
+-- name: RevokeSession :one
+UPDATE sessions
+SET revoked = true
+WHERE id = $1
+  AND user_id = $2
+  AND revoked = false
+RETURNING *;
+
+-- name: DeleteSessionTokens :exec
+DELETE
+FROM tokens
+WHERE session_id = $1;
@@ -0,0 +1,16 @@
+-- name: SuppressEmail :exec
+INSERT INTO suppressed_emails (email, reason, source, detail)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (email) DO UPDATE
+    SET reason     = EXCLUDED.reason,
+        source     = EXCLUDED.source,
+        detail     = EXCLUDED.detail,
+        created_at = now();
+
+-- name: GetEmailSuppression :one
+SELECT *
+FROM suppressed_emails
+WHERE email = $1;
+
+-- name: CheckEmailSuppressed :one
+SELECT EXISTS(SELECT 1 FROM suppressed_emails WHERE email = $1)::bool;
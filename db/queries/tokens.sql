@@ -1,6 +1,6 @@
 -- name: CreateToken :one
-INSERT INTO tokens (hash, user_id, expiry, scope)
-VALUES ($1, $2, $3, $4)
+INSERT INTO tokens (hash, user_id, expiry, scope, session_id)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: CheckToken :one
@@ -23,3 +23,32 @@ DELETE
 FROM tokens
 WHERE scope = $1
   AND user_id = $2;
+
+-- name: DeleteAllTokens :exec
+DELETE
+FROM tokens
+WHERE user_id = $1;
+
+-- name: GetTokenSessionID :one
+SELECT session_id
+FROM tokens
+WHERE hash = $1
+  AND scope = $2;
+
+-- name: DeleteStaleTokens :many
+DELETE
+FROM tokens
+WHERE hash IN (SELECT stale.hash
+               FROM tokens AS stale
+               WHERE stale.expiry < sqlc.arg('now')
+                  OR (stale.active = false AND stale.scope <> sqlc.arg('keep_inactive_scope'))
+               LIMIT sqlc.arg('batch_size'))
+RETURNING scope;
+
+-- name: ConsumeToken :one
+DELETE
+FROM tokens
+WHERE hash = $1
+  AND scope = $2
+  AND expiry > $3
+RETURNING user_id;
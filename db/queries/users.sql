@@ -1,6 +1,6 @@
 -- name: CreateUser :one
-INSERT INTO users (name, email, password_hash, activated)
-VALUES ($1, $2, $3, $4)
+INSERT INTO users (name, email, password_hash, activated, locale)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: CheckUser :one
@@ -12,21 +12,39 @@ SET name          = CASE WHEN @update_name::boolean THEN @name ELSE name END,
     email         = CASE WHEN @update_email::boolean THEN @email ELSE email END,
     password_hash = CASE WHEN @update_password_hash::boolean THEN @password_hash ELSE password_hash END,
     activated     = CASE WHEN @update_activated::boolean THEN @activated ELSE activated END,
+    disabled      = CASE WHEN @update_disabled::boolean THEN @disabled ELSE disabled END,
     version       = version + 1
 WHERE id = @id
   AND version = @version
 RETURNING *;
 
 -- name: GetUserFromEmail :one
-SELECT id, created_at, name, email, password_hash, activated, version
+SELECT id, created_at, name, email, password_hash, activated, version, locale, disabled
 FROM users
 WHERE email = $1;
 
 -- name: GetUserFromToken :one
-SELECT users.id, users.created_at, users.name, users.email, users.password_hash, users.activated, users.version
+SELECT users.id, users.created_at, users.name, users.email, users.password_hash, users.activated, users.version, users.locale, users.disabled
 FROM users
          INNER JOIN tokens
                     ON users.id = tokens.user_id
 WHERE tokens.hash = $1
   AND tokens.scope = $2
   AND tokens.expiry > $3;
+
+-- name: GetUserFromID :one
+SELECT id, created_at, name, email, password_hash, activated, version, locale, disabled
+FROM users
+WHERE id = $1;
+
+-- name: GetUsers :many
+SELECT id, created_at, name, email, password_hash, activated, version, locale, disabled
+FROM users
+WHERE (@search::text = '' OR name ILIKE '%' || @search || '%' OR email ILIKE '%' || @search || '%')
+ORDER BY id
+OFFSET sqlc.arg('offset') LIMIT sqlc.arg('limit');
+
+-- name: GetUserCount :one
+SELECT count(1)
+FROM users
+WHERE (@search::text = '' OR name ILIKE '%' || @search || '%' OR email ILIKE '%' || @search || '%');
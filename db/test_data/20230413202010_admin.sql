@@ -0,0 +1,5 @@
+-- migrate:up
+INSERT INTO users (name, email, password_hash, activated)
+VALUES ('managed', 'managed@test.com', '$2a$13$JHR5woNGzCO6MMhChSgs7OtU/vCADtSj/xb3kBT.fDmFVhuFOgISC', true);
+
+-- migrate:down
@@ -0,0 +1,8 @@
+-- migrate:up
+INSERT INTO users (name, email, password_hash, activated)
+VALUES ('locked', 'locked@test.com', '$2a$13$JHR5woNGzCO6MMhChSgs7OtU/vCADtSj/xb3kBT.fDmFVhuFOgISC', true);
+
+INSERT INTO tokens (scope, expiry, hash, user_id, active)
+VALUES ('unlock', '4000-01-01T00:00:00Z', '\x84CB22ECE77C0BFF906707D0AAF12BBDA8CA8464D3771DEF18FF5A6288ED40E4', 6, true);
+
+-- migrate:down
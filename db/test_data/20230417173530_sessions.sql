@@ -0,0 +1,14 @@
+-- migrate:up
+INSERT INTO sessions (ip_address, user_agent, user_id)
+VALUES ('127.0.0.1', 'test', 1);
+
+INSERT INTO sessions (ip_address, user_agent, revoked, user_id)
+VALUES ('127.0.0.1', 'test', true, 3);
+
+INSERT INTO tokens (scope, expiry, hash, user_id, active, session_id)
+VALUES ('refresh', '4000-01-01T00:00:00Z', '\x72860998A5C25E46E0C825364225831F2184EE78F4B121A8F304CF8186B909A3', 1, true, 1);
+
+INSERT INTO tokens (scope, expiry, hash, user_id, active, session_id)
+VALUES ('refresh', '4000-01-01T00:00:00Z', '\xF1E7957EFD620B645409DAF3D6A6BE427D0B7E2B6A1F64F3EE6E2F0538996B9F', 3, true, 2);
+
+-- migrate:down
@@ -0,0 +1,11 @@
+-- migrate:up
+INSERT INTO audit_events (event_type, user_id, actor_id, ip_address, user_agent, details)
+VALUES ('login.succeeded', 1, 1, '127.0.0.1', 'test', '{}');
+
+INSERT INTO audit_events (event_type, user_id, actor_id, ip_address, user_agent, details)
+VALUES ('user.registered', 5, 5, '127.0.0.1', 'test', '{}');
+
+INSERT INTO audit_events (event_type, user_id, actor_id, ip_address, user_agent, details)
+VALUES ('login.failed', NULL, NULL, '127.0.0.1', 'test', '{"email": "unknown@test.com", "reason": "unknown email"}');
+
+-- migrate:down
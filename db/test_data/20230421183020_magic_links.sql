@@ -0,0 +1,8 @@
+-- migrate:up
+INSERT INTO users (name, email, password_hash, activated)
+VALUES ('magic', 'magic@test.com', '$2a$13$JHR5woNGzCO6MMhChSgs7OtU/vCADtSj/xb3kBT.fDmFVhuFOgISC', false);
+
+INSERT INTO tokens (scope, expiry, hash, user_id, active)
+VALUES ('magic-link', '4000-01-01T00:00:00Z', '\x59627B19190072A7B76F0A47D5FB5861B27C8D78CAABC5B197205B78BB9E7DA0', 7, true);
+
+-- migrate:down
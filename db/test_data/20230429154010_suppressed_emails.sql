@@ -0,0 +1,8 @@
+-- migrate:up
+INSERT INTO users (name, email, password_hash, activated)
+VALUES ('bounced', 'bounced@test.com', '$2a$13$JHR5woNGzCO6MMhChSgs7OtU/vCADtSj/xb3kBT.fDmFVhuFOgISC', true);
+
+INSERT INTO suppressed_emails (email, reason, source, detail)
+VALUES ('bounced@test.com', 'bounce', 'ses', 'General: 550 5.1.1 user unknown');
+
+-- migrate:down
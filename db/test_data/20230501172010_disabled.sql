@@ -0,0 +1,9 @@
+-- migrate:up
+INSERT INTO users (name, email, password_hash, activated, disabled)
+VALUES ('disabled', 'disabled@test.com', '$2a$13$JHR5woNGzCO6MMhChSgs7OtU/vCADtSj/xb3kBT.fDmFVhuFOgISC', false, true);
+
+INSERT INTO tokens (scope, expiry, hash, user_id, active)
+VALUES ('activation', '4000-01-01T00:00:00Z', '\xE0F62171567DFBC1779EF9035CA30F6B74DFD82EBE45695D58F3DAAA5721270C', 9, true),
+       ('magic-link', '4000-01-01T00:00:00Z', '\x771DAC39C8BCDD9481201CE519FDE19A51B760A03029CB3C7F7E3A66B3D2F63D', 9, true);
+
+-- migrate:down
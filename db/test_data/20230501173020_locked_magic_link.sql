@@ -0,0 +1,11 @@
+-- migrate:up
+INSERT INTO users (name, email, password_hash, activated)
+VALUES ('lockedmagic', 'lockedmagic@test.com', '$2a$13$JHR5woNGzCO6MMhChSgs7OtU/vCADtSj/xb3kBT.fDmFVhuFOgISC', true);
+
+INSERT INTO lockouts (user_id, failed_logins, lockouts, locked_until)
+VALUES (10, 5, 1, '4000-01-01T00:00:00Z');
+
+INSERT INTO tokens (scope, expiry, hash, user_id, active)
+VALUES ('magic-link', '4000-01-01T00:00:00Z', '\x0E91448C7B473DC930F6EC0EF5BC43BB15473E3D7F3394ABDF39479077AA527A', 10, true);
+
+-- migrate:down
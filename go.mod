@@ -3,12 +3,12 @@ module github.com/seanflannery10/core
 go 1.19
 
 require (
+	github.com/emersion/go-msgauth v0.6.6
 	github.com/go-faster/errors v0.6.1
 	github.com/go-faster/jx v1.0.0
 	github.com/jackc/pgx/v5 v5.3.1
 	github.com/ogen-go/ogen v0.62.0
 	github.com/prometheus/client_golang v1.14.0
-	github.com/segmentio/asm v1.2.0
 	github.com/sethvargo/go-envconfig v0.9.0
 	github.com/stretchr/testify v1.8.2
 	github.com/wneessen/go-mail v0.3.9
@@ -18,6 +18,7 @@ require (
 	go.uber.org/multierr v1.11.0
 	golang.org/x/crypto v0.7.0
 	golang.org/x/exp v0.0.0-20230321023759-10a507213a29
+	golang.org/x/text v0.9.0
 )
 
 require (
@@ -43,13 +44,13 @@ require (
 	github.com/prometheus/common v0.42.0 // indirect
 	github.com/prometheus/procfs v0.9.0 // indirect
 	github.com/rogpeppe/go-internal v1.9.0 // indirect
+	github.com/segmentio/asm v1.2.0 // indirect
 	go.uber.org/atomic v1.10.0 // indirect
 	go.uber.org/goleak v1.2.1 // indirect
 	go.uber.org/zap v1.24.0 // indirect
 	golang.org/x/net v0.9.0 // indirect
 	golang.org/x/sync v0.1.0 // indirect
 	golang.org/x/sys v0.7.0 // indirect
-	golang.org/x/text v0.9.0 // indirect
 	google.golang.org/protobuf v1.30.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
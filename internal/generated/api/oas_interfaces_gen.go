@@ -0,0 +1,14 @@
+// Code generated by ogen, DO NOT EDIT.
+package api
+
+type NewActivationTokenRes interface {
+	newActivationTokenRes()
+}
+
+type NewPasswordResetTokenRes interface {
+	newPasswordResetTokenRes()
+}
+
+type NewUserRes interface {
+	newUserRes()
+}
@@ -55,6 +55,253 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "a"
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dmin/"
+					if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "audit-events"
+						if l := len("audit-events"); len(elem) >= l && elem[0:l] == "audit-events" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAdminGetAuditEventsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+					case 'u': // Prefix: "users"
+						if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleAdminGetUsersRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleAdminGetUserRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'd': // Prefix: "deactivate"
+									if l := len("deactivate"); len(elem) >= l && elem[0:l] == "deactivate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "PATCH":
+											s.handleAdminDeactivateUserRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "PATCH")
+										}
+
+										return
+									}
+								case 'p': // Prefix: "password-reset"
+									if l := len("password-reset"); len(elem) >= l && elem[0:l] == "password-reset" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAdminForcePasswordResetRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+								case 'r': // Prefix: "reactivate"
+									if l := len("reactivate"); len(elem) >= l && elem[0:l] == "reactivate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "PATCH":
+											s.handleAdminReactivateUserRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "PATCH")
+										}
+
+										return
+									}
+								case 's': // Prefix: "sessions"
+									if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleAdminGetUserSessionsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+								}
+							}
+						}
+					}
+				case 'p': // Prefix: "pi-keys"
+					if l := len("pi-keys"); len(elem) >= l && elem[0:l] == "pi-keys" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetUserAPIKeysRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleNewAPIKeyRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteAPIKeyRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE")
+							}
+
+							return
+						}
+					}
+				case 'u': // Prefix: "udit-events"
+					if l := len("udit-events"); len(elem) >= l && elem[0:l] == "udit-events" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetUserAuditEventsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+				}
 			case 'm': // Prefix: "messages"
 				if l := len("messages"); len(elem) >= l && elem[0:l] == "messages" {
 					elem = elem[l:]
@@ -106,6 +353,50 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							s.notAllowed(w, r, "DELETE,GET,PUT")
 						}
 
+						return
+					}
+				}
+			case 's': // Prefix: "sessions"
+				if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleGetUserSessionsRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteSessionRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE")
+						}
+
 						return
 					}
 				}
@@ -165,6 +456,43 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								s.notAllowed(w, r, "POST")
 							}
 
+							return
+						}
+					}
+				case 'm': // Prefix: "magic-link"
+					if l := len("magic-link"); len(elem) >= l && elem[0:l] == "magic-link" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "POST":
+							s.handleNewMagicLinkTokenRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/exchange"
+						if l := len("/exchange"); len(elem) >= l && elem[0:l] == "/exchange" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleExchangeMagicLinkTokenRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
 							return
 						}
 					}
@@ -232,6 +560,24 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							s.notAllowed(w, r, "PATCH")
 						}
 
+						return
+					}
+				case 'm': // Prefix: "me"
+					if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
 						return
 					}
 				case 'r': // Prefix: "register"
@@ -252,23 +598,53 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
-				case 'u': // Prefix: "update-password"
-					if l := len("update-password"); len(elem) >= l && elem[0:l] == "update-password" {
+				case 'u': // Prefix: "u"
+					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "PATCH":
-							s.handleUpdateUserPasswordRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "PATCH")
+						break
+					}
+					switch elem[0] {
+					case 'n': // Prefix: "nlock"
+						if l := len("nlock"); len(elem) >= l && elem[0:l] == "nlock" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "PATCH":
+								s.handleUnlockUserRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "PATCH")
+							}
+
+							return
+						}
+					case 'p': // Prefix: "pdate-password"
+						if l := len("pdate-password"); len(elem) >= l && elem[0:l] == "pdate-password" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "PATCH":
+								s.handleUpdateUserPasswordRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "PATCH")
+							}
+
+							return
+						}
 					}
 				}
 			}
@@ -352,6 +728,276 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "a"
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dmin/"
+					if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "audit-events"
+						if l := len("audit-events"); len(elem) >= l && elem[0:l] == "audit-events" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: AdminGetAuditEvents
+								r.name = "AdminGetAuditEvents"
+								r.operationID = "AdminGetAuditEvents"
+								r.pathPattern = "/v1/admin/audit-events"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+					case 'u': // Prefix: "users"
+						if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = "AdminGetUsers"
+								r.operationID = "AdminGetUsers"
+								r.pathPattern = "/v1/admin/users"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = "AdminGetUser"
+									r.operationID = "AdminGetUser"
+									r.pathPattern = "/v1/admin/users/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'd': // Prefix: "deactivate"
+									if l := len("deactivate"); len(elem) >= l && elem[0:l] == "deactivate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "PATCH":
+											// Leaf: AdminDeactivateUser
+											r.name = "AdminDeactivateUser"
+											r.operationID = "AdminDeactivateUser"
+											r.pathPattern = "/v1/admin/users/{id}/deactivate"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+								case 'p': // Prefix: "password-reset"
+									if l := len("password-reset"); len(elem) >= l && elem[0:l] == "password-reset" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											// Leaf: AdminForcePasswordReset
+											r.name = "AdminForcePasswordReset"
+											r.operationID = "AdminForcePasswordReset"
+											r.pathPattern = "/v1/admin/users/{id}/password-reset"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+								case 'r': // Prefix: "reactivate"
+									if l := len("reactivate"); len(elem) >= l && elem[0:l] == "reactivate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "PATCH":
+											// Leaf: AdminReactivateUser
+											r.name = "AdminReactivateUser"
+											r.operationID = "AdminReactivateUser"
+											r.pathPattern = "/v1/admin/users/{id}/reactivate"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+								case 's': // Prefix: "sessions"
+									if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: AdminGetUserSessions
+											r.name = "AdminGetUserSessions"
+											r.operationID = "AdminGetUserSessions"
+											r.pathPattern = "/v1/admin/users/{id}/sessions"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'p': // Prefix: "pi-keys"
+					if l := len("pi-keys"); len(elem) >= l && elem[0:l] == "pi-keys" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = "GetUserAPIKeys"
+							r.operationID = "GetUserAPIKeys"
+							r.pathPattern = "/v1/api-keys"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = "NewAPIKey"
+							r.operationID = "NewAPIKey"
+							r.pathPattern = "/v1/api-keys"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								// Leaf: DeleteAPIKey
+								r.name = "DeleteAPIKey"
+								r.operationID = "DeleteAPIKey"
+								r.pathPattern = "/v1/api-keys/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+					}
+				case 'u': // Prefix: "udit-events"
+					if l := len("udit-events"); len(elem) >= l && elem[0:l] == "udit-events" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: GetUserAuditEvents
+							r.name = "GetUserAuditEvents"
+							r.operationID = "GetUserAuditEvents"
+							r.pathPattern = "/v1/audit-events"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				}
 			case 'm': // Prefix: "messages"
 				if l := len("messages"); len(elem) >= l && elem[0:l] == "messages" {
 					elem = elem[l:]
@@ -423,6 +1069,54 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 				}
+			case 's': // Prefix: "sessions"
+				if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = "GetUserSessions"
+						r.operationID = "GetUserSessions"
+						r.pathPattern = "/v1/sessions"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							// Leaf: DeleteSession
+							r.name = "DeleteSession"
+							r.operationID = "DeleteSession"
+							r.pathPattern = "/v1/sessions/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+				}
 			case 't': // Prefix: "tokens/"
 				if l := len("tokens/"); len(elem) >= l && elem[0:l] == "tokens/" {
 					elem = elem[l:]
@@ -488,6 +1182,49 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 					}
+				case 'm': // Prefix: "magic-link"
+					if l := len("magic-link"); len(elem) >= l && elem[0:l] == "magic-link" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							r.name = "NewMagicLinkToken"
+							r.operationID = "NewMagicLinkToken"
+							r.pathPattern = "/v1/tokens/magic-link"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/exchange"
+						if l := len("/exchange"); len(elem) >= l && elem[0:l] == "/exchange" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: ExchangeMagicLinkToken
+								r.name = "ExchangeMagicLinkToken"
+								r.operationID = "ExchangeMagicLinkToken"
+								r.pathPattern = "/v1/tokens/magic-link/exchange"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+					}
 				case 'p': // Prefix: "password-reset"
 					if l := len("password-reset"); len(elem) >= l && elem[0:l] == "password-reset" {
 						elem = elem[l:]
@@ -563,6 +1300,27 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+				case 'm': // Prefix: "me"
+					if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: GetCurrentUser
+							r.name = "GetCurrentUser"
+							r.operationID = "GetCurrentUser"
+							r.pathPattern = "/v1/users/me"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
 				case 'r': // Prefix: "register"
 					if l := len("register"); len(elem) >= l && elem[0:l] == "register" {
 						elem = elem[l:]
@@ -584,25 +1342,58 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
-				case 'u': // Prefix: "update-password"
-					if l := len("update-password"); len(elem) >= l && elem[0:l] == "update-password" {
+				case 'u': // Prefix: "u"
+					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						switch method {
-						case "PATCH":
-							// Leaf: UpdateUserPassword
-							r.name = "UpdateUserPassword"
-							r.operationID = "UpdateUserPassword"
-							r.pathPattern = "/v1/users/update-password"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						break
+					}
+					switch elem[0] {
+					case 'n': // Prefix: "nlock"
+						if l := len("nlock"); len(elem) >= l && elem[0:l] == "nlock" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "PATCH":
+								// Leaf: UnlockUser
+								r.name = "UnlockUser"
+								r.operationID = "UnlockUser"
+								r.pathPattern = "/v1/users/unlock"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+					case 'p': // Prefix: "pdate-password"
+						if l := len("pdate-password"); len(elem) >= l && elem[0:l] == "pdate-password" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "PATCH":
+								// Leaf: UpdateUserPassword
+								r.name = "UpdateUserPassword"
+								r.operationID = "UpdateUserPassword"
+								r.pathPattern = "/v1/users/update-password"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
 					}
 				}
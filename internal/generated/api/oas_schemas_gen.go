@@ -5,12 +5,165 @@ package api
 import (
 	"fmt"
 	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 )
 
 func (s *ErrorResponseStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+type APIKey struct {
+	APIKey string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *APIKey) GetAPIKey() string {
+	return s.APIKey
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *APIKey) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// Contains an API key name, permissions and an optional expiry.
+// Ref: #/components/schemas/APIKeyRequest
+type APIKeyRequest struct {
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+	Expiry      OptDateTime  `json:"expiry"`
+}
+
+// GetName returns the value of Name.
+func (s *APIKeyRequest) GetName() string {
+	return s.Name
+}
+
+// GetPermissions returns the value of Permissions.
+func (s *APIKeyRequest) GetPermissions() []Permission {
+	return s.Permissions
+}
+
+// GetExpiry returns the value of Expiry.
+func (s *APIKeyRequest) GetExpiry() OptDateTime {
+	return s.Expiry
+}
+
+// SetName sets the value of Name.
+func (s *APIKeyRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetPermissions sets the value of Permissions.
+func (s *APIKeyRequest) SetPermissions(val []Permission) {
+	s.Permissions = val
+}
+
+// SetExpiry sets the value of Expiry.
+func (s *APIKeyRequest) SetExpiry(val OptDateTime) {
+	s.Expiry = val
+}
+
+// Contains an API key, the plaintext key is only set on creation.
+// Ref: #/components/schemas/APIKeyResponse
+type APIKeyResponse struct {
+	ID          int64        `json:"id"`
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+	Expiry      OptDateTime  `json:"expiry"`
+	LastUsedAt  OptDateTime  `json:"last_used_at"`
+	CreatedAt   time.Time    `json:"created_at"`
+	Key         OptString    `json:"key"`
+}
+
+// GetID returns the value of ID.
+func (s *APIKeyResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *APIKeyResponse) GetName() string {
+	return s.Name
+}
+
+// GetPermissions returns the value of Permissions.
+func (s *APIKeyResponse) GetPermissions() []Permission {
+	return s.Permissions
+}
+
+// GetExpiry returns the value of Expiry.
+func (s *APIKeyResponse) GetExpiry() OptDateTime {
+	return s.Expiry
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *APIKeyResponse) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *APIKeyResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetKey returns the value of Key.
+func (s *APIKeyResponse) GetKey() OptString {
+	return s.Key
+}
+
+// SetID sets the value of ID.
+func (s *APIKeyResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *APIKeyResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetPermissions sets the value of Permissions.
+func (s *APIKeyResponse) SetPermissions(val []Permission) {
+	s.Permissions = val
+}
+
+// SetExpiry sets the value of Expiry.
+func (s *APIKeyResponse) SetExpiry(val OptDateTime) {
+	s.Expiry = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *APIKeyResponse) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *APIKeyResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetKey sets the value of Key.
+func (s *APIKeyResponse) SetKey(val OptString) {
+	s.Key = val
+}
+
+// Contains API keys.
+// Ref: #/components/schemas/APIKeysResponse
+type APIKeysResponse struct {
+	APIKeys []APIKeyResponse `json:"api_keys"`
+}
+
+// GetAPIKeys returns the value of APIKeys.
+func (s *APIKeysResponse) GetAPIKeys() []APIKeyResponse {
+	return s.APIKeys
+}
+
+// SetAPIKeys sets the value of APIKeys.
+func (s *APIKeysResponse) SetAPIKeys(val []APIKeyResponse) {
+	s.APIKeys = val
+}
+
 // Contains a message.
 // Ref: #/components/schemas/AcceptanceResponse
 type AcceptanceResponse struct {
@@ -27,6 +180,10 @@ func (s *AcceptanceResponse) SetMessage(val string) {
 	s.Message = val
 }
 
+func (*AcceptanceResponse) newActivationTokenRes()    {}
+func (*AcceptanceResponse) newPasswordResetTokenRes() {}
+func (*AcceptanceResponse) newUserRes()               {}
+
 type Access struct {
 	Token string
 }
@@ -41,10 +198,437 @@ func (s *Access) SetToken(val string) {
 	s.Token = val
 }
 
-// Contains an error.
+// Contains a user as seen by an administrator.
+// Ref: #/components/schemas/AdminUserResponse
+type AdminUserResponse struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Activated bool   `json:"activated"`
+	// Set when an administrator deactivated the user, only reactivating clears it.
+	Disabled  bool      `json:"disabled"`
+	CreatedAt time.Time `json:"created_at"`
+	Version   int32     `json:"version"`
+}
+
+// GetID returns the value of ID.
+func (s *AdminUserResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *AdminUserResponse) GetName() string {
+	return s.Name
+}
+
+// GetEmail returns the value of Email.
+func (s *AdminUserResponse) GetEmail() string {
+	return s.Email
+}
+
+// GetActivated returns the value of Activated.
+func (s *AdminUserResponse) GetActivated() bool {
+	return s.Activated
+}
+
+// GetDisabled returns the value of Disabled.
+func (s *AdminUserResponse) GetDisabled() bool {
+	return s.Disabled
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AdminUserResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetVersion returns the value of Version.
+func (s *AdminUserResponse) GetVersion() int32 {
+	return s.Version
+}
+
+// SetID sets the value of ID.
+func (s *AdminUserResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *AdminUserResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetEmail sets the value of Email.
+func (s *AdminUserResponse) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetActivated sets the value of Activated.
+func (s *AdminUserResponse) SetActivated(val bool) {
+	s.Activated = val
+}
+
+// SetDisabled sets the value of Disabled.
+func (s *AdminUserResponse) SetDisabled(val bool) {
+	s.Disabled = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AdminUserResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetVersion sets the value of Version.
+func (s *AdminUserResponse) SetVersion(val int32) {
+	s.Version = val
+}
+
+// Contains users and metadata objects.
+// Ref: #/components/schemas/AdminUsersResponse
+type AdminUsersResponse struct {
+	Users    []AdminUserResponse      `json:"users"`
+	Metadata MessagesMetadataResponse `json:"metadata"`
+}
+
+// GetUsers returns the value of Users.
+func (s *AdminUsersResponse) GetUsers() []AdminUserResponse {
+	return s.Users
+}
+
+// GetMetadata returns the value of Metadata.
+func (s *AdminUsersResponse) GetMetadata() MessagesMetadataResponse {
+	return s.Metadata
+}
+
+// SetUsers sets the value of Users.
+func (s *AdminUsersResponse) SetUsers(val []AdminUserResponse) {
+	s.Users = val
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *AdminUsersResponse) SetMetadata(val MessagesMetadataResponse) {
+	s.Metadata = val
+}
+
+// Contains a security relevant event.
+// Ref: #/components/schemas/AuditEventResponse
+type AuditEventResponse struct {
+	ID        int64                     `json:"id"`
+	CreatedAt time.Time                 `json:"created_at"`
+	EventType AuditEventType            `json:"event_type"`
+	UserID    OptInt64                  `json:"user_id"`
+	ActorID   OptInt64                  `json:"actor_id"`
+	IPAddress string                    `json:"ip_address"`
+	UserAgent string                    `json:"user_agent"`
+	Details   AuditEventResponseDetails `json:"details"`
+}
+
+// GetID returns the value of ID.
+func (s *AuditEventResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AuditEventResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetEventType returns the value of EventType.
+func (s *AuditEventResponse) GetEventType() AuditEventType {
+	return s.EventType
+}
+
+// GetUserID returns the value of UserID.
+func (s *AuditEventResponse) GetUserID() OptInt64 {
+	return s.UserID
+}
+
+// GetActorID returns the value of ActorID.
+func (s *AuditEventResponse) GetActorID() OptInt64 {
+	return s.ActorID
+}
+
+// GetIPAddress returns the value of IPAddress.
+func (s *AuditEventResponse) GetIPAddress() string {
+	return s.IPAddress
+}
+
+// GetUserAgent returns the value of UserAgent.
+func (s *AuditEventResponse) GetUserAgent() string {
+	return s.UserAgent
+}
+
+// GetDetails returns the value of Details.
+func (s *AuditEventResponse) GetDetails() AuditEventResponseDetails {
+	return s.Details
+}
+
+// SetID sets the value of ID.
+func (s *AuditEventResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AuditEventResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *AuditEventResponse) SetEventType(val AuditEventType) {
+	s.EventType = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *AuditEventResponse) SetUserID(val OptInt64) {
+	s.UserID = val
+}
+
+// SetActorID sets the value of ActorID.
+func (s *AuditEventResponse) SetActorID(val OptInt64) {
+	s.ActorID = val
+}
+
+// SetIPAddress sets the value of IPAddress.
+func (s *AuditEventResponse) SetIPAddress(val string) {
+	s.IPAddress = val
+}
+
+// SetUserAgent sets the value of UserAgent.
+func (s *AuditEventResponse) SetUserAgent(val string) {
+	s.UserAgent = val
+}
+
+// SetDetails sets the value of Details.
+func (s *AuditEventResponse) SetDetails(val AuditEventResponseDetails) {
+	s.Details = val
+}
+
+type AuditEventResponseDetails map[string]jx.Raw
+
+func (s *AuditEventResponseDetails) init() AuditEventResponseDetails {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// The type of a security relevant event.
+// Ref: #/components/schemas/AuditEventType
+type AuditEventType string
+
+const (
+	AuditEventTypeUserRegistered           AuditEventType = "user.registered"
+	AuditEventTypeUserActivated            AuditEventType = "user.activated"
+	AuditEventTypeLoginSucceeded           AuditEventType = "login.succeeded"
+	AuditEventTypeLoginFailed              AuditEventType = "login.failed"
+	AuditEventTypeAccountLocked            AuditEventType = "account.locked"
+	AuditEventTypeAccountUnlocked          AuditEventType = "account.unlocked"
+	AuditEventTypeMagicLinkRequested       AuditEventType = "magic_link.requested"
+	AuditEventTypeRefreshTokenReused       AuditEventType = "refresh_token.reused"
+	AuditEventTypePasswordResetRequested   AuditEventType = "password_reset.requested"
+	AuditEventTypePasswordChanged          AuditEventType = "password.changed"
+	AuditEventTypeSessionRevoked           AuditEventType = "session.revoked"
+	AuditEventTypeAPIKeyCreated            AuditEventType = "api_key.created"
+	AuditEventTypeAPIKeyRevoked            AuditEventType = "api_key.revoked"
+	AuditEventTypeAdminUserDeactivated     AuditEventType = "admin.user_deactivated"
+	AuditEventTypeAdminUserReactivated     AuditEventType = "admin.user_reactivated"
+	AuditEventTypeAdminPasswordResetForced AuditEventType = "admin.password_reset_forced"
+	AuditEventTypeEmailSuppressed          AuditEventType = "email.suppressed"
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditEventTypeUserRegistered:
+		return []byte(s), nil
+	case AuditEventTypeUserActivated:
+		return []byte(s), nil
+	case AuditEventTypeLoginSucceeded:
+		return []byte(s), nil
+	case AuditEventTypeLoginFailed:
+		return []byte(s), nil
+	case AuditEventTypeAccountLocked:
+		return []byte(s), nil
+	case AuditEventTypeAccountUnlocked:
+		return []byte(s), nil
+	case AuditEventTypeMagicLinkRequested:
+		return []byte(s), nil
+	case AuditEventTypeRefreshTokenReused:
+		return []byte(s), nil
+	case AuditEventTypePasswordResetRequested:
+		return []byte(s), nil
+	case AuditEventTypePasswordChanged:
+		return []byte(s), nil
+	case AuditEventTypeSessionRevoked:
+		return []byte(s), nil
+	case AuditEventTypeAPIKeyCreated:
+		return []byte(s), nil
+	case AuditEventTypeAPIKeyRevoked:
+		return []byte(s), nil
+	case AuditEventTypeAdminUserDeactivated:
+		return []byte(s), nil
+	case AuditEventTypeAdminUserReactivated:
+		return []byte(s), nil
+	case AuditEventTypeAdminPasswordResetForced:
+		return []byte(s), nil
+	case AuditEventTypeEmailSuppressed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditEventType) UnmarshalText(data []byte) error {
+	switch AuditEventType(data) {
+	case AuditEventTypeUserRegistered:
+		*s = AuditEventTypeUserRegistered
+		return nil
+	case AuditEventTypeUserActivated:
+		*s = AuditEventTypeUserActivated
+		return nil
+	case AuditEventTypeLoginSucceeded:
+		*s = AuditEventTypeLoginSucceeded
+		return nil
+	case AuditEventTypeLoginFailed:
+		*s = AuditEventTypeLoginFailed
+		return nil
+	case AuditEventTypeAccountLocked:
+		*s = AuditEventTypeAccountLocked
+		return nil
+	case AuditEventTypeAccountUnlocked:
+		*s = AuditEventTypeAccountUnlocked
+		return nil
+	case AuditEventTypeMagicLinkRequested:
+		*s = AuditEventTypeMagicLinkRequested
+		return nil
+	case AuditEventTypeRefreshTokenReused:
+		*s = AuditEventTypeRefreshTokenReused
+		return nil
+	case AuditEventTypePasswordResetRequested:
+		*s = AuditEventTypePasswordResetRequested
+		return nil
+	case AuditEventTypePasswordChanged:
+		*s = AuditEventTypePasswordChanged
+		return nil
+	case AuditEventTypeSessionRevoked:
+		*s = AuditEventTypeSessionRevoked
+		return nil
+	case AuditEventTypeAPIKeyCreated:
+		*s = AuditEventTypeAPIKeyCreated
+		return nil
+	case AuditEventTypeAPIKeyRevoked:
+		*s = AuditEventTypeAPIKeyRevoked
+		return nil
+	case AuditEventTypeAdminUserDeactivated:
+		*s = AuditEventTypeAdminUserDeactivated
+		return nil
+	case AuditEventTypeAdminUserReactivated:
+		*s = AuditEventTypeAdminUserReactivated
+		return nil
+	case AuditEventTypeAdminPasswordResetForced:
+		*s = AuditEventTypeAdminPasswordResetForced
+		return nil
+	case AuditEventTypeEmailSuppressed:
+		*s = AuditEventTypeEmailSuppressed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Contains audit events and metadata objects.
+// Ref: #/components/schemas/AuditEventsResponse
+type AuditEventsResponse struct {
+	Events   []AuditEventResponse     `json:"events"`
+	Metadata MessagesMetadataResponse `json:"metadata"`
+}
+
+// GetEvents returns the value of Events.
+func (s *AuditEventsResponse) GetEvents() []AuditEventResponse {
+	return s.Events
+}
+
+// GetMetadata returns the value of Metadata.
+func (s *AuditEventsResponse) GetMetadata() MessagesMetadataResponse {
+	return s.Metadata
+}
+
+// SetEvents sets the value of Events.
+func (s *AuditEventsResponse) SetEvents(val []AuditEventResponse) {
+	s.Events = val
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *AuditEventsResponse) SetMetadata(val MessagesMetadataResponse) {
+	s.Metadata = val
+}
+
+// Why email to an address is suppressed, a hard bounce or a spam complaint, and since when.
+// Ref: #/components/schemas/EmailSuppression
+type EmailSuppression struct {
+	Reason EmailSuppressionReason `json:"reason"`
+	Since  time.Time              `json:"since"`
+}
+
+// GetReason returns the value of Reason.
+func (s *EmailSuppression) GetReason() EmailSuppressionReason {
+	return s.Reason
+}
+
+// GetSince returns the value of Since.
+func (s *EmailSuppression) GetSince() time.Time {
+	return s.Since
+}
+
+// SetReason sets the value of Reason.
+func (s *EmailSuppression) SetReason(val EmailSuppressionReason) {
+	s.Reason = val
+}
+
+// SetSince sets the value of Since.
+func (s *EmailSuppression) SetSince(val time.Time) {
+	s.Since = val
+}
+
+type EmailSuppressionReason string
+
+const (
+	EmailSuppressionReasonBounce    EmailSuppressionReason = "bounce"
+	EmailSuppressionReasonComplaint EmailSuppressionReason = "complaint"
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EmailSuppressionReason) MarshalText() ([]byte, error) {
+	switch s {
+	case EmailSuppressionReasonBounce:
+		return []byte(s), nil
+	case EmailSuppressionReasonComplaint:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EmailSuppressionReason) UnmarshalText(data []byte) error {
+	switch EmailSuppressionReason(data) {
+	case EmailSuppressionReasonBounce:
+		*s = EmailSuppressionReasonBounce
+		return nil
+	case EmailSuppressionReasonComplaint:
+		*s = EmailSuppressionReasonComplaint
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Contains an error and, for password policy errors, the rule that failed.
 // Ref: #/components/schemas/ErrorResponse
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Error string               `json:"error"`
+	Rule  OptErrorResponseRule `json:"rule"`
 }
 
 // GetError returns the value of Error.
@@ -52,11 +636,60 @@ func (s *ErrorResponse) GetError() string {
 	return s.Error
 }
 
+// GetRule returns the value of Rule.
+func (s *ErrorResponse) GetRule() OptErrorResponseRule {
+	return s.Rule
+}
+
 // SetError sets the value of Error.
 func (s *ErrorResponse) SetError(val string) {
 	s.Error = val
 }
 
+// SetRule sets the value of Rule.
+func (s *ErrorResponse) SetRule(val OptErrorResponseRule) {
+	s.Rule = val
+}
+
+type ErrorResponseRule string
+
+const (
+	ErrorResponseRuleBreached     ErrorResponseRule = "breached"
+	ErrorResponseRulePersonalInfo ErrorResponseRule = "personal_info"
+	ErrorResponseRuleTooWeak      ErrorResponseRule = "too_weak"
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ErrorResponseRule) MarshalText() ([]byte, error) {
+	switch s {
+	case ErrorResponseRuleBreached:
+		return []byte(s), nil
+	case ErrorResponseRulePersonalInfo:
+		return []byte(s), nil
+	case ErrorResponseRuleTooWeak:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ErrorResponseRule) UnmarshalText(data []byte) error {
+	switch ErrorResponseRule(data) {
+	case ErrorResponseRuleBreached:
+		*s = ErrorResponseRuleBreached
+		return nil
+	case ErrorResponseRulePersonalInfo:
+		*s = ErrorResponseRulePersonalInfo
+		return nil
+	case ErrorResponseRuleTooWeak:
+		*s = ErrorResponseRuleTooWeak
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // ErrorResponseStatusCode wraps ErrorResponse with StatusCode.
 type ErrorResponseStatusCode struct {
 	StatusCode int
@@ -224,6 +857,190 @@ func (s *MessagesResponse) SetMetadata(val MessagesMetadataResponse) {
 	s.Metadata = val
 }
 
+// NewOptAuditEventType returns new OptAuditEventType with value set to v.
+func NewOptAuditEventType(v AuditEventType) OptAuditEventType {
+	return OptAuditEventType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuditEventType is optional AuditEventType.
+type OptAuditEventType struct {
+	Value AuditEventType
+	Set   bool
+}
+
+// IsSet returns true if OptAuditEventType was set.
+func (o OptAuditEventType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuditEventType) Reset() {
+	var v AuditEventType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAuditEventType) SetTo(v AuditEventType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditEventType) Get() (v AuditEventType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditEventType) Or(d AuditEventType) AuditEventType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEmailSuppression returns new OptEmailSuppression with value set to v.
+func NewOptEmailSuppression(v EmailSuppression) OptEmailSuppression {
+	return OptEmailSuppression{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEmailSuppression is optional EmailSuppression.
+type OptEmailSuppression struct {
+	Value EmailSuppression
+	Set   bool
+}
+
+// IsSet returns true if OptEmailSuppression was set.
+func (o OptEmailSuppression) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEmailSuppression) Reset() {
+	var v EmailSuppression
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEmailSuppression) SetTo(v EmailSuppression) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEmailSuppression) Get() (v EmailSuppression, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEmailSuppression) Or(d EmailSuppression) EmailSuppression {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptErrorResponseRule returns new OptErrorResponseRule with value set to v.
+func NewOptErrorResponseRule(v ErrorResponseRule) OptErrorResponseRule {
+	return OptErrorResponseRule{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptErrorResponseRule is optional ErrorResponseRule.
+type OptErrorResponseRule struct {
+	Value ErrorResponseRule
+	Set   bool
+}
+
+// IsSet returns true if OptErrorResponseRule was set.
+func (o OptErrorResponseRule) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptErrorResponseRule) Reset() {
+	var v ErrorResponseRule
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptErrorResponseRule) SetTo(v ErrorResponseRule) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptErrorResponseRule) Get() (v ErrorResponseRule, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptErrorResponseRule) Or(d ErrorResponseRule) ErrorResponseRule {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptInt32 returns new OptInt32 with value set to v.
 func NewOptInt32(v int32) OptInt32 {
 	return OptInt32{
@@ -270,6 +1087,52 @@ func (o OptInt32) Or(d int32) int32 {
 	return d
 }
 
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptString returns new OptString with value set to v.
 func NewOptString(v string) OptString {
 	return OptString{
@@ -316,6 +1179,41 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
+// A permission that can be granted to an API key.
+// Ref: #/components/schemas/Permission
+type Permission string
+
+const (
+	PermissionMessagesRead  Permission = "messages:read"
+	PermissionMessagesWrite Permission = "messages:write"
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Permission) MarshalText() ([]byte, error) {
+	switch s {
+	case PermissionMessagesRead:
+		return []byte(s), nil
+	case PermissionMessagesWrite:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Permission) UnmarshalText(data []byte) error {
+	switch Permission(data) {
+	case PermissionMessagesRead:
+		*s = PermissionMessagesRead
+		return nil
+	case PermissionMessagesWrite:
+		*s = PermissionMessagesWrite
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type Refresh struct {
 	APIKey string
 }
@@ -330,6 +1228,82 @@ func (s *Refresh) SetAPIKey(val string) {
 	s.APIKey = val
 }
 
+// Contains a logged in session and the device it was last used from.
+// Ref: #/components/schemas/SessionResponse
+type SessionResponse struct {
+	ID         int64     `json:"id"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+// GetID returns the value of ID.
+func (s *SessionResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetIPAddress returns the value of IPAddress.
+func (s *SessionResponse) GetIPAddress() string {
+	return s.IPAddress
+}
+
+// GetUserAgent returns the value of UserAgent.
+func (s *SessionResponse) GetUserAgent() string {
+	return s.UserAgent
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SessionResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *SessionResponse) GetLastUsedAt() time.Time {
+	return s.LastUsedAt
+}
+
+// SetID sets the value of ID.
+func (s *SessionResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetIPAddress sets the value of IPAddress.
+func (s *SessionResponse) SetIPAddress(val string) {
+	s.IPAddress = val
+}
+
+// SetUserAgent sets the value of UserAgent.
+func (s *SessionResponse) SetUserAgent(val string) {
+	s.UserAgent = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SessionResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *SessionResponse) SetLastUsedAt(val time.Time) {
+	s.LastUsedAt = val
+}
+
+// Contains sessions.
+// Ref: #/components/schemas/SessionsResponse
+type SessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// GetSessions returns the value of Sessions.
+func (s *SessionsResponse) GetSessions() []SessionResponse {
+	return s.Sessions
+}
+
+// SetSessions sets the value of Sessions.
+func (s *SessionsResponse) SetSessions(val []SessionResponse) {
+	s.Sessions = val
+}
+
 // Contains a plaintext token as well as optional properties.
 // Ref: #/components/schemas/TokenRequest
 type TokenRequest struct {
@@ -384,6 +1358,9 @@ func (s *TokenResponse) SetToken(val string) {
 	s.Token = val
 }
 
+func (*TokenResponse) newActivationTokenRes()    {}
+func (*TokenResponse) newPasswordResetTokenRes() {}
+
 // TokenResponseHeaders wraps TokenResponse with response headers.
 type TokenResponseHeaders struct {
 	SetCookie OptString
@@ -480,12 +1457,107 @@ func (s *UserLoginRequest) SetPassword(val string) {
 	s.Password = val
 }
 
+// Contains the signed in user, email_suppression is set when we no longer send them email.
+// Ref: #/components/schemas/UserProfileResponse
+type UserProfileResponse struct {
+	ID               int64               `json:"id"`
+	Name             string              `json:"name"`
+	Email            string              `json:"email"`
+	Locale           string              `json:"locale"`
+	Activated        bool                `json:"activated"`
+	CreatedAt        time.Time           `json:"created_at"`
+	Version          int32               `json:"version"`
+	EmailSuppression OptEmailSuppression `json:"email_suppression"`
+}
+
+// GetID returns the value of ID.
+func (s *UserProfileResponse) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *UserProfileResponse) GetName() string {
+	return s.Name
+}
+
+// GetEmail returns the value of Email.
+func (s *UserProfileResponse) GetEmail() string {
+	return s.Email
+}
+
+// GetLocale returns the value of Locale.
+func (s *UserProfileResponse) GetLocale() string {
+	return s.Locale
+}
+
+// GetActivated returns the value of Activated.
+func (s *UserProfileResponse) GetActivated() bool {
+	return s.Activated
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *UserProfileResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetVersion returns the value of Version.
+func (s *UserProfileResponse) GetVersion() int32 {
+	return s.Version
+}
+
+// GetEmailSuppression returns the value of EmailSuppression.
+func (s *UserProfileResponse) GetEmailSuppression() OptEmailSuppression {
+	return s.EmailSuppression
+}
+
+// SetID sets the value of ID.
+func (s *UserProfileResponse) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *UserProfileResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetEmail sets the value of Email.
+func (s *UserProfileResponse) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetLocale sets the value of Locale.
+func (s *UserProfileResponse) SetLocale(val string) {
+	s.Locale = val
+}
+
+// SetActivated sets the value of Activated.
+func (s *UserProfileResponse) SetActivated(val bool) {
+	s.Activated = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *UserProfileResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetVersion sets the value of Version.
+func (s *UserProfileResponse) SetVersion(val int32) {
+	s.Version = val
+}
+
+// SetEmailSuppression sets the value of EmailSuppression.
+func (s *UserProfileResponse) SetEmailSuppression(val OptEmailSuppression) {
+	s.EmailSuppression = val
+}
+
 // Contains a username, email and password.
 // Ref: #/components/schemas/UserRequest
 type UserRequest struct {
 	Name     string `json:"name"`
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// BCP 47 language tag of the user's emails, defaults to the Accept-Language header.
+	Locale OptString `json:"locale"`
 }
 
 // GetName returns the value of Name.
@@ -503,6 +1575,11 @@ func (s *UserRequest) GetPassword() string {
 	return s.Password
 }
 
+// GetLocale returns the value of Locale.
+func (s *UserRequest) GetLocale() OptString {
+	return s.Locale
+}
+
 // SetName sets the value of Name.
 func (s *UserRequest) SetName(val string) {
 	s.Name = val
@@ -518,6 +1595,11 @@ func (s *UserRequest) SetPassword(val string) {
 	s.Password = val
 }
 
+// SetLocale sets the value of Locale.
+func (s *UserRequest) SetLocale(val OptString) {
+	s.Locale = val
+}
+
 // Contains a username, email and password.
 // Ref: #/components/schemas/UserResponse
 type UserResponse struct {
@@ -555,3 +1637,5 @@ func (s *UserResponse) SetEmail(val string) {
 func (s *UserResponse) SetVersion(val int32) {
 	s.Version = val
 }
+
+func (*UserResponse) newUserRes() {}
@@ -10,6 +10,8 @@ import (
 
 // SecurityHandler is handler for security parameters.
 type SecurityHandler interface {
+	// HandleAPIKey handles APIKey security.
+	HandleAPIKey(ctx context.Context, operationName string, t APIKey) (context.Context, error)
 	// HandleAccess handles Access security.
 	HandleAccess(ctx context.Context, operationName string, t Access) (context.Context, error)
 	// HandleRefresh handles Refresh security.
@@ -31,6 +33,20 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 	return "", false
 }
 
+func (s *Server) securityAPIKey(ctx context.Context, operationName string, req *http.Request) (context.Context, bool, error) {
+	var t APIKey
+	const parameterName = "X-Api-Key"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	rctx, err := s.sec.HandleAPIKey(ctx, operationName, t)
+	if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
 func (s *Server) securityAccess(ctx context.Context, operationName string, req *http.Request) (context.Context, bool, error) {
 	var t Access
 	token, ok := findAuthorization(req.Header, "Bearer")
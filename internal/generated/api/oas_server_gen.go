@@ -12,18 +12,78 @@ type Handler interface {
 	//
 	// PATCH /v1/users/activate
 	ActivateUser(ctx context.Context, req *TokenRequest) (*UserResponse, error)
+	// AdminDeactivateUser implements AdminDeactivateUser operation.
+	//
+	// PATCH /v1/admin/users/{id}/deactivate
+	AdminDeactivateUser(ctx context.Context, params AdminDeactivateUserParams) (*AdminUserResponse, error)
+	// AdminForcePasswordReset implements AdminForcePasswordReset operation.
+	//
+	// POST /v1/admin/users/{id}/password-reset
+	AdminForcePasswordReset(ctx context.Context, params AdminForcePasswordResetParams) (*AcceptanceResponse, error)
+	// AdminGetAuditEvents implements AdminGetAuditEvents operation.
+	//
+	// GET /v1/admin/audit-events
+	AdminGetAuditEvents(ctx context.Context, params AdminGetAuditEventsParams) (*AuditEventsResponse, error)
+	// AdminGetUser implements AdminGetUser operation.
+	//
+	// GET /v1/admin/users/{id}
+	AdminGetUser(ctx context.Context, params AdminGetUserParams) (*AdminUserResponse, error)
+	// AdminGetUserSessions implements AdminGetUserSessions operation.
+	//
+	// GET /v1/admin/users/{id}/sessions
+	AdminGetUserSessions(ctx context.Context, params AdminGetUserSessionsParams) (*SessionsResponse, error)
+	// AdminGetUsers implements AdminGetUsers operation.
+	//
+	// GET /v1/admin/users
+	AdminGetUsers(ctx context.Context, params AdminGetUsersParams) (*AdminUsersResponse, error)
+	// AdminReactivateUser implements AdminReactivateUser operation.
+	//
+	// PATCH /v1/admin/users/{id}/reactivate
+	AdminReactivateUser(ctx context.Context, params AdminReactivateUserParams) (*AdminUserResponse, error)
+	// DeleteAPIKey implements DeleteAPIKey operation.
+	//
+	// DELETE /v1/api-keys/{id}
+	DeleteAPIKey(ctx context.Context, params DeleteAPIKeyParams) (*AcceptanceResponse, error)
 	// DeleteMessage implements DeleteMessage operation.
 	//
 	// DELETE /v1/messages/{id}
 	DeleteMessage(ctx context.Context, params DeleteMessageParams) (*AcceptanceResponse, error)
+	// DeleteSession implements DeleteSession operation.
+	//
+	// DELETE /v1/sessions/{id}
+	DeleteSession(ctx context.Context, params DeleteSessionParams) (*AcceptanceResponse, error)
+	// ExchangeMagicLinkToken implements ExchangeMagicLinkToken operation.
+	//
+	// POST /v1/tokens/magic-link/exchange
+	ExchangeMagicLinkToken(ctx context.Context, req *TokenRequest) (*TokenResponseHeaders, error)
+	// GetCurrentUser implements GetCurrentUser operation.
+	//
+	// GET /v1/users/me
+	GetCurrentUser(ctx context.Context) (*UserProfileResponse, error)
 	// GetMessage implements GetMessage operation.
 	//
 	// GET /v1/messages/{id}
 	GetMessage(ctx context.Context, params GetMessageParams) (*MessageResponse, error)
+	// GetUserAPIKeys implements GetUserAPIKeys operation.
+	//
+	// GET /v1/api-keys
+	GetUserAPIKeys(ctx context.Context) (*APIKeysResponse, error)
+	// GetUserAuditEvents implements GetUserAuditEvents operation.
+	//
+	// GET /v1/audit-events
+	GetUserAuditEvents(ctx context.Context, params GetUserAuditEventsParams) (*AuditEventsResponse, error)
 	// GetUserMessages implements GetUserMessages operation.
 	//
 	// GET /v1/messages
 	GetUserMessages(ctx context.Context, params GetUserMessagesParams) (*MessagesResponse, error)
+	// GetUserSessions implements GetUserSessions operation.
+	//
+	// GET /v1/sessions
+	GetUserSessions(ctx context.Context) (*SessionsResponse, error)
+	// NewAPIKey implements NewAPIKey operation.
+	//
+	// POST /v1/api-keys
+	NewAPIKey(ctx context.Context, req *APIKeyRequest) (*APIKeyResponse, error)
 	// NewAccessToken implements NewAccessToken operation.
 	//
 	// POST /v1/tokens/access
@@ -31,7 +91,11 @@ type Handler interface {
 	// NewActivationToken implements NewActivationToken operation.
 	//
 	// POST /v1/tokens/activation
-	NewActivationToken(ctx context.Context, req *UserEmailRequest) (*TokenResponse, error)
+	NewActivationToken(ctx context.Context, req *UserEmailRequest) (NewActivationTokenRes, error)
+	// NewMagicLinkToken implements NewMagicLinkToken operation.
+	//
+	// POST /v1/tokens/magic-link
+	NewMagicLinkToken(ctx context.Context, req *UserEmailRequest) (*AcceptanceResponse, error)
 	// NewMessage implements NewMessage operation.
 	//
 	// POST /v1/messages
@@ -39,7 +103,7 @@ type Handler interface {
 	// NewPasswordResetToken implements NewPasswordResetToken operation.
 	//
 	// POST /v1/tokens/password-reset
-	NewPasswordResetToken(ctx context.Context, req *UserEmailRequest) (*TokenResponse, error)
+	NewPasswordResetToken(ctx context.Context, req *UserEmailRequest) (NewPasswordResetTokenRes, error)
 	// NewRefreshToken implements NewRefreshToken operation.
 	//
 	// POST /v1/tokens/refresh
@@ -47,7 +111,11 @@ type Handler interface {
 	// NewUser implements NewUser operation.
 	//
 	// POST /v1/users/register
-	NewUser(ctx context.Context, req *UserRequest) (*UserResponse, error)
+	NewUser(ctx context.Context, req *UserRequest) (NewUserRes, error)
+	// UnlockUser implements UnlockUser operation.
+	//
+	// PATCH /v1/users/unlock
+	UnlockUser(ctx context.Context, req *TokenRequest) (*AcceptanceResponse, error)
 	// UpdateMessage implements UpdateMessage operation.
 	//
 	// PUT /v1/messages/{id}
@@ -20,6 +20,62 @@ func (UnimplementedHandler) ActivateUser(ctx context.Context, req *TokenRequest)
 	return r, ht.ErrNotImplemented
 }
 
+// AdminDeactivateUser implements AdminDeactivateUser operation.
+//
+// PATCH /v1/admin/users/{id}/deactivate
+func (UnimplementedHandler) AdminDeactivateUser(ctx context.Context, params AdminDeactivateUserParams) (r *AdminUserResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminForcePasswordReset implements AdminForcePasswordReset operation.
+//
+// POST /v1/admin/users/{id}/password-reset
+func (UnimplementedHandler) AdminForcePasswordReset(ctx context.Context, params AdminForcePasswordResetParams) (r *AcceptanceResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminGetAuditEvents implements AdminGetAuditEvents operation.
+//
+// GET /v1/admin/audit-events
+func (UnimplementedHandler) AdminGetAuditEvents(ctx context.Context, params AdminGetAuditEventsParams) (r *AuditEventsResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminGetUser implements AdminGetUser operation.
+//
+// GET /v1/admin/users/{id}
+func (UnimplementedHandler) AdminGetUser(ctx context.Context, params AdminGetUserParams) (r *AdminUserResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminGetUserSessions implements AdminGetUserSessions operation.
+//
+// GET /v1/admin/users/{id}/sessions
+func (UnimplementedHandler) AdminGetUserSessions(ctx context.Context, params AdminGetUserSessionsParams) (r *SessionsResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminGetUsers implements AdminGetUsers operation.
+//
+// GET /v1/admin/users
+func (UnimplementedHandler) AdminGetUsers(ctx context.Context, params AdminGetUsersParams) (r *AdminUsersResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminReactivateUser implements AdminReactivateUser operation.
+//
+// PATCH /v1/admin/users/{id}/reactivate
+func (UnimplementedHandler) AdminReactivateUser(ctx context.Context, params AdminReactivateUserParams) (r *AdminUserResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteAPIKey implements DeleteAPIKey operation.
+//
+// DELETE /v1/api-keys/{id}
+func (UnimplementedHandler) DeleteAPIKey(ctx context.Context, params DeleteAPIKeyParams) (r *AcceptanceResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteMessage implements DeleteMessage operation.
 //
 // DELETE /v1/messages/{id}
@@ -27,6 +83,27 @@ func (UnimplementedHandler) DeleteMessage(ctx context.Context, params DeleteMess
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteSession implements DeleteSession operation.
+//
+// DELETE /v1/sessions/{id}
+func (UnimplementedHandler) DeleteSession(ctx context.Context, params DeleteSessionParams) (r *AcceptanceResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ExchangeMagicLinkToken implements ExchangeMagicLinkToken operation.
+//
+// POST /v1/tokens/magic-link/exchange
+func (UnimplementedHandler) ExchangeMagicLinkToken(ctx context.Context, req *TokenRequest) (r *TokenResponseHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetCurrentUser implements GetCurrentUser operation.
+//
+// GET /v1/users/me
+func (UnimplementedHandler) GetCurrentUser(ctx context.Context) (r *UserProfileResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetMessage implements GetMessage operation.
 //
 // GET /v1/messages/{id}
@@ -34,6 +111,20 @@ func (UnimplementedHandler) GetMessage(ctx context.Context, params GetMessagePar
 	return r, ht.ErrNotImplemented
 }
 
+// GetUserAPIKeys implements GetUserAPIKeys operation.
+//
+// GET /v1/api-keys
+func (UnimplementedHandler) GetUserAPIKeys(ctx context.Context) (r *APIKeysResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetUserAuditEvents implements GetUserAuditEvents operation.
+//
+// GET /v1/audit-events
+func (UnimplementedHandler) GetUserAuditEvents(ctx context.Context, params GetUserAuditEventsParams) (r *AuditEventsResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetUserMessages implements GetUserMessages operation.
 //
 // GET /v1/messages
@@ -41,6 +132,20 @@ func (UnimplementedHandler) GetUserMessages(ctx context.Context, params GetUserM
 	return r, ht.ErrNotImplemented
 }
 
+// GetUserSessions implements GetUserSessions operation.
+//
+// GET /v1/sessions
+func (UnimplementedHandler) GetUserSessions(ctx context.Context) (r *SessionsResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NewAPIKey implements NewAPIKey operation.
+//
+// POST /v1/api-keys
+func (UnimplementedHandler) NewAPIKey(ctx context.Context, req *APIKeyRequest) (r *APIKeyResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewAccessToken implements NewAccessToken operation.
 //
 // POST /v1/tokens/access
@@ -51,7 +156,14 @@ func (UnimplementedHandler) NewAccessToken(ctx context.Context) (r *TokenRespons
 // NewActivationToken implements NewActivationToken operation.
 //
 // POST /v1/tokens/activation
-func (UnimplementedHandler) NewActivationToken(ctx context.Context, req *UserEmailRequest) (r *TokenResponse, _ error) {
+func (UnimplementedHandler) NewActivationToken(ctx context.Context, req *UserEmailRequest) (r NewActivationTokenRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NewMagicLinkToken implements NewMagicLinkToken operation.
+//
+// POST /v1/tokens/magic-link
+func (UnimplementedHandler) NewMagicLinkToken(ctx context.Context, req *UserEmailRequest) (r *AcceptanceResponse, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -65,7 +177,7 @@ func (UnimplementedHandler) NewMessage(ctx context.Context, req *MessageRequest)
 // NewPasswordResetToken implements NewPasswordResetToken operation.
 //
 // POST /v1/tokens/password-reset
-func (UnimplementedHandler) NewPasswordResetToken(ctx context.Context, req *UserEmailRequest) (r *TokenResponse, _ error) {
+func (UnimplementedHandler) NewPasswordResetToken(ctx context.Context, req *UserEmailRequest) (r NewPasswordResetTokenRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -79,7 +191,14 @@ func (UnimplementedHandler) NewRefreshToken(ctx context.Context, req *UserLoginR
 // NewUser implements NewUser operation.
 //
 // POST /v1/users/register
-func (UnimplementedHandler) NewUser(ctx context.Context, req *UserRequest) (r *UserResponse, _ error) {
+func (UnimplementedHandler) NewUser(ctx context.Context, req *UserRequest) (r NewUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockUser implements UnlockUser operation.
+//
+// PATCH /v1/users/unlock
+func (UnimplementedHandler) UnlockUser(ctx context.Context, req *TokenRequest) (r *AcceptanceResponse, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -0,0 +1,187 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.17.2
+// source: api_keys.sql
+
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO api_keys (name, hash, permissions, expiry, user_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at, name, hash, permissions, expiry, last_used_at, user_id
+`
+
+type CreateAPIKeyParams struct {
+	Name        string
+	Hash        []byte
+	Permissions []string
+	Expiry      pgtype.Timestamp
+	UserID      int64
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (*ApiKey, error) {
+	row := q.db.QueryRow(ctx, createAPIKey,
+		arg.Name,
+		arg.Hash,
+		arg.Permissions,
+		arg.Expiry,
+		arg.UserID,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Name,
+		&i.Hash,
+		&i.Permissions,
+		&i.Expiry,
+		&i.LastUsedAt,
+		&i.UserID,
+	)
+	return &i, err
+}
+
+const deleteAPIKey = `-- name: DeleteAPIKey :one
+DELETE
+FROM api_keys
+WHERE id = $1
+  AND user_id = $2
+RETURNING id, created_at, name, hash, permissions, expiry, last_used_at, user_id
+`
+
+type DeleteAPIKeyParams struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) DeleteAPIKey(ctx context.Context, arg DeleteAPIKeyParams) (*ApiKey, error) {
+	row := q.db.QueryRow(ctx, deleteAPIKey, arg.ID, arg.UserID)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Name,
+		&i.Hash,
+		&i.Permissions,
+		&i.Expiry,
+		&i.LastUsedAt,
+		&i.UserID,
+	)
+	return &i, err
+}
+
+const getUserAPIKeys = `-- name: GetUserAPIKeys :many
+SELECT id, created_at, name, hash, permissions, expiry, last_used_at, user_id
+FROM api_keys
+WHERE user_id = $1
+ORDER BY id
+`
+
+func (q *Queries) GetUserAPIKeys(ctx context.Context, userID int64) ([]*ApiKey, error) {
+	rows, err := q.db.Query(ctx, getUserAPIKeys, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Name,
+			&i.Hash,
+			&i.Permissions,
+			&i.Expiry,
+			&i.LastUsedAt,
+			&i.UserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserFromAPIKey = `-- name: GetUserFromAPIKey :one
+SELECT users.id,
+       users.created_at,
+       users.name,
+       users.email,
+       users.password_hash,
+       users.activated,
+       users.version,
+       users.locale,
+       users.disabled,
+       api_keys.id AS api_key_id,
+       api_keys.permissions
+FROM users
+         INNER JOIN api_keys
+                    ON users.id = api_keys.user_id
+WHERE api_keys.hash = $1
+  AND (api_keys.expiry IS NULL OR api_keys.expiry > $2::timestamp)
+`
+
+type GetUserFromAPIKeyParams struct {
+	Hash []byte
+	Now  time.Time
+}
+
+type GetUserFromAPIKeyRow struct {
+	ID           int64
+	CreatedAt    time.Time
+	Name         string
+	Email        string
+	PasswordHash []byte
+	Activated    bool
+	Version      int32
+	Locale       string
+	Disabled     bool
+	ApiKeyID     int64
+	Permissions  []string
+}
+
+func (q *Queries) GetUserFromAPIKey(ctx context.Context, arg GetUserFromAPIKeyParams) (*GetUserFromAPIKeyRow, error) {
+	row := q.db.QueryRow(ctx, getUserFromAPIKey, arg.Hash, arg.Now)
+	var i GetUserFromAPIKeyRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Name,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Activated,
+		&i.Version,
+		&i.Locale,
+		&i.Disabled,
+		&i.ApiKeyID,
+		&i.Permissions,
+	)
+	return &i, err
+}
+
+const updateAPIKeyLastUsed = `-- name: UpdateAPIKeyLastUsed :exec
+UPDATE api_keys
+SET last_used_at = $1::timestamp
+WHERE id = $2
+`
+
+type UpdateAPIKeyLastUsedParams struct {
+	LastUsedAt time.Time
+	ID         int64
+}
+
+func (q *Queries) UpdateAPIKeyLastUsed(ctx context.Context, arg UpdateAPIKeyLastUsedParams) error {
+	_, err := q.db.Exec(ctx, updateAPIKeyLastUsed, arg.LastUsedAt, arg.ID)
+	return err
+}
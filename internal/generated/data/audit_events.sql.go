@@ -0,0 +1,158 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.17.2
+// source: audit_events.sql
+
+package data
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditEvent = `-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (event_type, user_id, actor_id, ip_address, user_agent, details)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateAuditEventParams struct {
+	EventType string
+	UserID    pgtype.Int8
+	ActorID   pgtype.Int8
+	IpAddress string
+	UserAgent string
+	Details   []byte
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error {
+	_, err := q.db.Exec(ctx, createAuditEvent,
+		arg.EventType,
+		arg.UserID,
+		arg.ActorID,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.Details,
+	)
+	return err
+}
+
+const exportUserAuditEvents = `-- name: ExportUserAuditEvents :many
+SELECT id, created_at, event_type, user_id, actor_id, ip_address, user_agent, details
+FROM audit_events
+WHERE user_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ExportUserAuditEvents(ctx context.Context, userID pgtype.Int8) ([]*AuditEvent, error) {
+	rows, err := q.db.Query(ctx, exportUserAuditEvents, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.EventType,
+			&i.UserID,
+			&i.ActorID,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Details,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuditEventCount = `-- name: GetAuditEventCount :one
+SELECT count(1)
+FROM audit_events
+WHERE ($1::bigint IS NULL OR user_id = $1)
+  AND ($2::text IS NULL OR event_type = $2)
+  AND ($3::timestamp IS NULL OR created_at >= $3)
+  AND ($4::timestamp IS NULL OR created_at < $4)
+`
+
+type GetAuditEventCountParams struct {
+	UserID    pgtype.Int8
+	EventType pgtype.Text
+	Since     pgtype.Timestamp
+	Until     pgtype.Timestamp
+}
+
+func (q *Queries) GetAuditEventCount(ctx context.Context, arg GetAuditEventCountParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getAuditEventCount,
+		arg.UserID,
+		arg.EventType,
+		arg.Since,
+		arg.Until,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getAuditEvents = `-- name: GetAuditEvents :many
+SELECT id, created_at, event_type, user_id, actor_id, ip_address, user_agent, details
+FROM audit_events
+WHERE ($1::bigint IS NULL OR user_id = $1)
+  AND ($2::text IS NULL OR event_type = $2)
+  AND ($3::timestamp IS NULL OR created_at >= $3)
+  AND ($4::timestamp IS NULL OR created_at < $4)
+ORDER BY id DESC
+OFFSET $5 LIMIT $6
+`
+
+type GetAuditEventsParams struct {
+	UserID    pgtype.Int8
+	EventType pgtype.Text
+	Since     pgtype.Timestamp
+	Until     pgtype.Timestamp
+	Offset    int32
+	Limit     int32
+}
+
+func (q *Queries) GetAuditEvents(ctx context.Context, arg GetAuditEventsParams) ([]*AuditEvent, error) {
+	rows, err := q.db.Query(ctx, getAuditEvents,
+		arg.UserID,
+		arg.EventType,
+		arg.Since,
+		arg.Until,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.EventType,
+			&i.UserID,
+			&i.ActorID,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Details,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
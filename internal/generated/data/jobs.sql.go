@@ -0,0 +1,89 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.17.2
+// source: jobs.sql
+
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimJobSchedule = `-- name: ClaimJobSchedule :execrows
+UPDATE job_schedules
+SET next_run_at = $1
+WHERE name = $2
+  AND next_run_at <= $3::timestamp
+`
+
+type ClaimJobScheduleParams struct {
+	NextRunAt time.Time
+	Name      string
+	Now       time.Time
+}
+
+func (q *Queries) ClaimJobSchedule(ctx context.Context, arg ClaimJobScheduleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimJobSchedule, arg.NextRunAt, arg.Name, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueJob = `-- name: EnqueueJob :one
+INSERT INTO jobs (kind, args, unique_key, run_at, max_attempts)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (kind, unique_key) WHERE state = 'pending' DO NOTHING
+RETURNING id
+`
+
+type EnqueueJobParams struct {
+	Kind        string
+	Args        []byte
+	UniqueKey   pgtype.Text
+	RunAt       time.Time
+	MaxAttempts int32
+}
+
+func (q *Queries) EnqueueJob(ctx context.Context, arg EnqueueJobParams) (int64, error) {
+	row := q.db.QueryRow(ctx, enqueueJob,
+		arg.Kind,
+		arg.Args,
+		arg.UniqueKey,
+		arg.RunAt,
+		arg.MaxAttempts,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertJobSchedule = `-- name: InsertJobSchedule :exec
+INSERT INTO job_schedules (name, next_run_at)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type InsertJobScheduleParams struct {
+	Name      string
+	NextRunAt time.Time
+}
+
+func (q *Queries) InsertJobSchedule(ctx context.Context, arg InsertJobScheduleParams) error {
+	_, err := q.db.Exec(ctx, insertJobSchedule, arg.Name, arg.NextRunAt)
+	return err
+}
+
+const tryLockSchedule = `-- name: TryLockSchedule :one
+SELECT pg_try_advisory_xact_lock(hashtext($1::text))::bool
+`
+
+func (q *Queries) TryLockSchedule(ctx context.Context, name string) (bool, error) {
+	row := q.db.QueryRow(ctx, tryLockSchedule, name)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
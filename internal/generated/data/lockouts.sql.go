@@ -0,0 +1,110 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.17.2
+// source: lockouts.sql
+
+package data
+
+import (
+	"context"
+	"time"
+)
+
+const checkKnownDevices = `-- name: CheckKnownDevices :one
+SELECT EXISTS(SELECT 1 FROM known_devices WHERE user_id = $1)::bool
+`
+
+func (q *Queries) CheckKnownDevices(ctx context.Context, userID int64) (bool, error) {
+	row := q.db.QueryRow(ctx, checkKnownDevices, userID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const deleteLockout = `-- name: DeleteLockout :exec
+DELETE
+FROM lockouts
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteLockout(ctx context.Context, userID int64) error {
+	_, err := q.db.Exec(ctx, deleteLockout, userID)
+	return err
+}
+
+const getLockout = `-- name: GetLockout :one
+SELECT user_id, failed_logins, lockouts, locked_until
+FROM lockouts
+WHERE user_id = $1
+`
+
+func (q *Queries) GetLockout(ctx context.Context, userID int64) (*Lockout, error) {
+	row := q.db.QueryRow(ctx, getLockout, userID)
+	var i Lockout
+	err := row.Scan(
+		&i.UserID,
+		&i.FailedLogins,
+		&i.Lockouts,
+		&i.LockedUntil,
+	)
+	return &i, err
+}
+
+const lockUser = `-- name: LockUser :exec
+UPDATE lockouts
+SET failed_logins = 0,
+    lockouts      = lockouts + 1,
+    locked_until  = $1::timestamp
+WHERE user_id = $2
+`
+
+type LockUserParams struct {
+	LockedUntil time.Time
+	UserID      int64
+}
+
+func (q *Queries) LockUser(ctx context.Context, arg LockUserParams) error {
+	_, err := q.db.Exec(ctx, lockUser, arg.LockedUntil, arg.UserID)
+	return err
+}
+
+const recordFailedLogin = `-- name: RecordFailedLogin :one
+INSERT INTO lockouts (user_id, failed_logins)
+VALUES ($1, 1)
+ON CONFLICT (user_id) DO UPDATE
+    SET failed_logins = lockouts.failed_logins + 1
+RETURNING user_id, failed_logins, lockouts, locked_until
+`
+
+func (q *Queries) RecordFailedLogin(ctx context.Context, userID int64) (*Lockout, error) {
+	row := q.db.QueryRow(ctx, recordFailedLogin, userID)
+	var i Lockout
+	err := row.Scan(
+		&i.UserID,
+		&i.FailedLogins,
+		&i.Lockouts,
+		&i.LockedUntil,
+	)
+	return &i, err
+}
+
+const upsertKnownDevice = `-- name: UpsertKnownDevice :one
+INSERT INTO known_devices (user_id, ip_address, user_agent)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, ip_address, user_agent) DO UPDATE
+    SET last_seen = now()
+RETURNING (xmax = 0)::bool AS inserted
+`
+
+type UpsertKnownDeviceParams struct {
+	UserID    int64
+	IpAddress string
+	UserAgent string
+}
+
+func (q *Queries) UpsertKnownDevice(ctx context.Context, arg UpsertKnownDeviceParams) (bool, error) {
+	row := q.db.QueryRow(ctx, upsertKnownDevice, arg.UserID, arg.IpAddress, arg.UserAgent)
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
+}
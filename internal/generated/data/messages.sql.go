@@ -59,6 +59,43 @@ func (q *Queries) DeleteMessage(ctx context.Context, arg DeleteMessageParams) (*
 	return &i, err
 }
 
+const exportUserMessages = `-- name: ExportUserMessages :many
+SELECT id,
+       created_at,
+       message,
+       user_id,
+       version
+FROM messages
+WHERE user_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ExportUserMessages(ctx context.Context, userID int64) ([]*Message, error) {
+	rows, err := q.db.Query(ctx, exportUserMessages, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Message,
+			&i.UserID,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMessage = `-- name: GetMessage :one
 SELECT id, created_at, message, user_id, version
 FROM messages
@@ -6,8 +6,65 @@ package data
 
 import (
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiKey struct {
+	ID          int64
+	CreatedAt   time.Time
+	Name        string
+	Hash        []byte
+	Permissions []string
+	Expiry      pgtype.Timestamp
+	LastUsedAt  pgtype.Timestamp
+	UserID      int64
+}
+
+type AuditEvent struct {
+	ID        int64
+	CreatedAt time.Time
+	EventType string
+	UserID    pgtype.Int8
+	ActorID   pgtype.Int8
+	IpAddress string
+	UserAgent string
+	Details   []byte
+}
+
+type Job struct {
+	ID          int64
+	CreatedAt   time.Time
+	Kind        string
+	Args        []byte
+	State       string
+	UniqueKey   pgtype.Text
+	RunAt       time.Time
+	Attempts    int32
+	MaxAttempts int32
+	LastError   pgtype.Text
+}
+
+type JobSchedule struct {
+	Name      string
+	NextRunAt time.Time
+}
+
+type KnownDevice struct {
+	UserID    int64
+	IpAddress string
+	UserAgent string
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+type Lockout struct {
+	UserID       int64
+	FailedLogins int32
+	Lockouts     int32
+	LockedUntil  pgtype.Timestamp
+}
+
 type Message struct {
 	ID        int64
 	CreatedAt time.Time
@@ -16,12 +73,46 @@ type Message struct {
 	Version   int32
 }
 
+type Permission struct {
+	ID   int64
+	Code string
+}
+
+type Role struct {
+	ID   int64
+	Name string
+}
+
+type RolesPermission struct {
+	RoleID       int64
+	PermissionID int64
+}
+
+type Session struct {
+	ID         int64
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	IpAddress  string
+	UserAgent  string
+	Revoked    bool
+	UserID     int64
+}
+
+type SuppressedEmail struct {
+	Email     string
+	CreatedAt time.Time
+	Reason    string
+	Source    string
+	Detail    string
+}
+
 type Token struct {
-	Scope  string
-	Expiry time.Time
-	Hash   []byte
-	UserID int64
-	Active bool
+	Scope     string
+	Expiry    time.Time
+	Hash      []byte
+	UserID    int64
+	Active    bool
+	SessionID pgtype.Int8
 }
 
 type User struct {
@@ -32,4 +123,11 @@ type User struct {
 	PasswordHash []byte
 	Activated    bool
 	Version      int32
+	Locale       string
+	Disabled     bool
+}
+
+type UsersRole struct {
+	UserID int64
+	RoleID int64
 }
@@ -0,0 +1,69 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.17.2
+// source: roles.sql
+
+package data
+
+import (
+	"context"
+)
+
+const checkRole = `-- name: CheckRole :one
+SELECT EXISTS(SELECT id FROM roles WHERE name = $1)::bool
+`
+
+func (q *Queries) CheckRole(ctx context.Context, name string) (bool, error) {
+	row := q.db.QueryRow(ctx, checkRole, name)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getUserPermissions = `-- name: GetUserPermissions :many
+SELECT DISTINCT permissions.code
+FROM permissions
+         INNER JOIN roles_permissions
+                    ON permissions.id = roles_permissions.permission_id
+         INNER JOIN users_roles
+                    ON roles_permissions.role_id = users_roles.role_id
+WHERE users_roles.user_id = $1
+`
+
+func (q *Queries) GetUserPermissions(ctx context.Context, userID int64) ([]string, error) {
+	rows, err := q.db.Query(ctx, getUserPermissions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return nil, err
+		}
+		items = append(items, code)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const grantUserRole = `-- name: GrantUserRole :exec
+INSERT INTO users_roles (user_id, role_id)
+SELECT $1, roles.id
+FROM roles
+WHERE roles.name = $2
+ON CONFLICT DO NOTHING
+`
+
+type GrantUserRoleParams struct {
+	UserID int64
+	Name   string
+}
+
+func (q *Queries) GrantUserRole(ctx context.Context, arg GrantUserRoleParams) error {
+	_, err := q.db.Exec(ctx, grantUserRole, arg.UserID, arg.Name)
+	return err
+}
@@ -0,0 +1,173 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.17.2
+// source: sessions.sql
+
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createSession = `-- name: CreateSession :one
+INSERT INTO sessions (ip_address, user_agent, user_id)
+VALUES ($1, $2, $3)
+RETURNING id, created_at, last_used_at, ip_address, user_agent, revoked, user_id
+`
+
+type CreateSessionParams struct {
+	IpAddress string
+	UserAgent string
+	UserID    int64
+}
+
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (*Session, error) {
+	row := q.db.QueryRow(ctx, createSession, arg.IpAddress, arg.UserAgent, arg.UserID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.Revoked,
+		&i.UserID,
+	)
+	return &i, err
+}
+
+const deleteSessionTokens = `-- name: DeleteSessionTokens :exec
+DELETE
+FROM tokens
+WHERE session_id = $1
+`
+
+func (q *Queries) DeleteSessionTokens(ctx context.Context, sessionID pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deleteSessionTokens, sessionID)
+	return err
+}
+
+const getSession = `-- name: GetSession :one
+SELECT id, created_at, last_used_at, ip_address, user_agent, revoked, user_id
+FROM sessions
+WHERE id = $1
+`
+
+func (q *Queries) GetSession(ctx context.Context, id int64) (*Session, error) {
+	row := q.db.QueryRow(ctx, getSession, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.Revoked,
+		&i.UserID,
+	)
+	return &i, err
+}
+
+const getUserSessions = `-- name: GetUserSessions :many
+SELECT sessions.id,
+       sessions.created_at,
+       sessions.last_used_at,
+       sessions.ip_address,
+       sessions.user_agent,
+       sessions.revoked,
+       sessions.user_id
+FROM sessions
+WHERE sessions.user_id = $1
+  AND sessions.revoked = false
+  AND EXISTS(SELECT 1
+             FROM tokens
+             WHERE tokens.session_id = sessions.id
+               AND tokens.scope = 'refresh'
+               AND tokens.active = true
+               AND tokens.expiry > $2::timestamp)
+ORDER BY sessions.last_used_at DESC
+`
+
+type GetUserSessionsParams struct {
+	UserID int64
+	Now    time.Time
+}
+
+func (q *Queries) GetUserSessions(ctx context.Context, arg GetUserSessionsParams) ([]*Session, error) {
+	rows, err := q.db.Query(ctx, getUserSessions, arg.UserID, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Revoked,
+			&i.UserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeSession = `-- name: RevokeSession :one
+UPDATE sessions
+SET revoked = true
+WHERE id = $1
+  AND user_id = $2
+  AND revoked = false
+RETURNING id, created_at, last_used_at, ip_address, user_agent, revoked, user_id
+`
+
+type RevokeSessionParams struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) RevokeSession(ctx context.Context, arg RevokeSessionParams) (*Session, error) {
+	row := q.db.QueryRow(ctx, revokeSession, arg.ID, arg.UserID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.Revoked,
+		&i.UserID,
+	)
+	return &i, err
+}
+
+const touchSession = `-- name: TouchSession :exec
+UPDATE sessions
+SET last_used_at = now(),
+    ip_address   = $1,
+    user_agent   = $2
+WHERE id = $3
+`
+
+type TouchSessionParams struct {
+	IpAddress string
+	UserAgent string
+	ID        int64
+}
+
+func (q *Queries) TouchSession(ctx context.Context, arg TouchSessionParams) error {
+	_, err := q.db.Exec(ctx, touchSession, arg.IpAddress, arg.UserAgent, arg.ID)
+	return err
+}
@@ -0,0 +1,67 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.17.2
+// source: suppressed_emails.sql
+
+package data
+
+import (
+	"context"
+)
+
+const checkEmailSuppressed = `-- name: CheckEmailSuppressed :one
+SELECT EXISTS(SELECT 1 FROM suppressed_emails WHERE email = $1)::bool
+`
+
+func (q *Queries) CheckEmailSuppressed(ctx context.Context, email string) (bool, error) {
+	row := q.db.QueryRow(ctx, checkEmailSuppressed, email)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getEmailSuppression = `-- name: GetEmailSuppression :one
+SELECT email, created_at, reason, source, detail
+FROM suppressed_emails
+WHERE email = $1
+`
+
+func (q *Queries) GetEmailSuppression(ctx context.Context, email string) (*SuppressedEmail, error) {
+	row := q.db.QueryRow(ctx, getEmailSuppression, email)
+	var i SuppressedEmail
+	err := row.Scan(
+		&i.Email,
+		&i.CreatedAt,
+		&i.Reason,
+		&i.Source,
+		&i.Detail,
+	)
+	return &i, err
+}
+
+const suppressEmail = `-- name: SuppressEmail :exec
+INSERT INTO suppressed_emails (email, reason, source, detail)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (email) DO UPDATE
+    SET reason     = EXCLUDED.reason,
+        source     = EXCLUDED.source,
+        detail     = EXCLUDED.detail,
+        created_at = now()
+`
+
+type SuppressEmailParams struct {
+	Email  string
+	Reason string
+	Source string
+	Detail string
+}
+
+func (q *Queries) SuppressEmail(ctx context.Context, arg SuppressEmailParams) error {
+	_, err := q.db.Exec(ctx, suppressEmail,
+		arg.Email,
+		arg.Reason,
+		arg.Source,
+		arg.Detail,
+	)
+	return err
+}
@@ -8,6 +8,8 @@ package data
 import (
 	"context"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const checkToken = `-- name: CheckToken :one
@@ -32,17 +34,40 @@ func (q *Queries) CheckToken(ctx context.Context, arg CheckTokenParams) (bool, e
 	return column_1, err
 }
 
-const createToken = `-- name: CreateToken :one
-INSERT INTO tokens (hash, user_id, expiry, scope)
-VALUES ($1, $2, $3, $4)
-RETURNING scope, expiry, hash, user_id, active
+const consumeToken = `-- name: ConsumeToken :one
+DELETE
+FROM tokens
+WHERE hash = $1
+  AND scope = $2
+  AND expiry > $3
+RETURNING user_id
 `
 
-type CreateTokenParams struct {
+type ConsumeTokenParams struct {
 	Hash   []byte
-	UserID int64
-	Expiry time.Time
 	Scope  string
+	Expiry time.Time
+}
+
+func (q *Queries) ConsumeToken(ctx context.Context, arg ConsumeTokenParams) (int64, error) {
+	row := q.db.QueryRow(ctx, consumeToken, arg.Hash, arg.Scope, arg.Expiry)
+	var user_id int64
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const createToken = `-- name: CreateToken :one
+INSERT INTO tokens (hash, user_id, expiry, scope, session_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING scope, expiry, hash, user_id, active, session_id
+`
+
+type CreateTokenParams struct {
+	Hash      []byte
+	UserID    int64
+	Expiry    time.Time
+	Scope     string
+	SessionID pgtype.Int8
 }
 
 func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (*Token, error) {
@@ -51,6 +76,7 @@ func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (*Toke
 		arg.UserID,
 		arg.Expiry,
 		arg.Scope,
+		arg.SessionID,
 	)
 	var i Token
 	err := row.Scan(
@@ -59,6 +85,7 @@ func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (*Toke
 		&i.Hash,
 		&i.UserID,
 		&i.Active,
+		&i.SessionID,
 	)
 	return &i, err
 }
@@ -82,6 +109,54 @@ func (q *Queries) DeactivateToken(ctx context.Context, arg DeactivateTokenParams
 	return err
 }
 
+const deleteAllTokens = `-- name: DeleteAllTokens :exec
+DELETE
+FROM tokens
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteAllTokens(ctx context.Context, userID int64) error {
+	_, err := q.db.Exec(ctx, deleteAllTokens, userID)
+	return err
+}
+
+const deleteStaleTokens = `-- name: DeleteStaleTokens :many
+DELETE
+FROM tokens
+WHERE hash IN (SELECT stale.hash
+               FROM tokens AS stale
+               WHERE stale.expiry < $1
+                  OR (stale.active = false AND stale.scope <> $2)
+               LIMIT $3)
+RETURNING scope
+`
+
+type DeleteStaleTokensParams struct {
+	Now               time.Time
+	KeepInactiveScope string
+	BatchSize         int32
+}
+
+func (q *Queries) DeleteStaleTokens(ctx context.Context, arg DeleteStaleTokensParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, deleteStaleTokens, arg.Now, arg.KeepInactiveScope, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var scope string
+		if err := rows.Scan(&scope); err != nil {
+			return nil, err
+		}
+		items = append(items, scope)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteTokens = `-- name: DeleteTokens :exec
 DELETE
 FROM tokens
@@ -98,3 +173,22 @@ func (q *Queries) DeleteTokens(ctx context.Context, arg DeleteTokensParams) erro
 	_, err := q.db.Exec(ctx, deleteTokens, arg.Scope, arg.UserID)
 	return err
 }
+
+const getTokenSessionID = `-- name: GetTokenSessionID :one
+SELECT session_id
+FROM tokens
+WHERE hash = $1
+  AND scope = $2
+`
+
+type GetTokenSessionIDParams struct {
+	Hash  []byte
+	Scope string
+}
+
+func (q *Queries) GetTokenSessionID(ctx context.Context, arg GetTokenSessionIDParams) (pgtype.Int8, error) {
+	row := q.db.QueryRow(ctx, getTokenSessionID, arg.Hash, arg.Scope)
+	var session_id pgtype.Int8
+	err := row.Scan(&session_id)
+	return session_id, err
+}
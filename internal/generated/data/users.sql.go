@@ -22,9 +22,9 @@ func (q *Queries) CheckUser(ctx context.Context, email string) (bool, error) {
 }
 
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (name, email, password_hash, activated)
-VALUES ($1, $2, $3, $4)
-RETURNING id, created_at, name, email, password_hash, activated, version
+INSERT INTO users (name, email, password_hash, activated, locale)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at, name, email, password_hash, activated, version, locale, disabled
 `
 
 type CreateUserParams struct {
@@ -32,6 +32,7 @@ type CreateUserParams struct {
 	Email        string
 	PasswordHash []byte
 	Activated    bool
+	Locale       string
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (*User, error) {
@@ -40,6 +41,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (*User,
 		arg.Email,
 		arg.PasswordHash,
 		arg.Activated,
+		arg.Locale,
 	)
 	var i User
 	err := row.Scan(
@@ -50,12 +52,27 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (*User,
 		&i.PasswordHash,
 		&i.Activated,
 		&i.Version,
+		&i.Locale,
+		&i.Disabled,
 	)
 	return &i, err
 }
 
+const getUserCount = `-- name: GetUserCount :one
+SELECT count(1)
+FROM users
+WHERE ($1::text = '' OR name ILIKE '%' || $1 || '%' OR email ILIKE '%' || $1 || '%')
+`
+
+func (q *Queries) GetUserCount(ctx context.Context, search string) (int64, error) {
+	row := q.db.QueryRow(ctx, getUserCount, search)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getUserFromEmail = `-- name: GetUserFromEmail :one
-SELECT id, created_at, name, email, password_hash, activated, version
+SELECT id, created_at, name, email, password_hash, activated, version, locale, disabled
 FROM users
 WHERE email = $1
 `
@@ -71,12 +88,37 @@ func (q *Queries) GetUserFromEmail(ctx context.Context, email string) (*User, er
 		&i.PasswordHash,
 		&i.Activated,
 		&i.Version,
+		&i.Locale,
+		&i.Disabled,
+	)
+	return &i, err
+}
+
+const getUserFromID = `-- name: GetUserFromID :one
+SELECT id, created_at, name, email, password_hash, activated, version, locale, disabled
+FROM users
+WHERE id = $1
+`
+
+func (q *Queries) GetUserFromID(ctx context.Context, id int64) (*User, error) {
+	row := q.db.QueryRow(ctx, getUserFromID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Name,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Activated,
+		&i.Version,
+		&i.Locale,
+		&i.Disabled,
 	)
 	return &i, err
 }
 
 const getUserFromToken = `-- name: GetUserFromToken :one
-SELECT users.id, users.created_at, users.name, users.email, users.password_hash, users.activated, users.version
+SELECT users.id, users.created_at, users.name, users.email, users.password_hash, users.activated, users.version, users.locale, users.disabled
 FROM users
          INNER JOIN tokens
                     ON users.id = tokens.user_id
@@ -102,20 +144,67 @@ func (q *Queries) GetUserFromToken(ctx context.Context, arg GetUserFromTokenPara
 		&i.PasswordHash,
 		&i.Activated,
 		&i.Version,
+		&i.Locale,
+		&i.Disabled,
 	)
 	return &i, err
 }
 
+const getUsers = `-- name: GetUsers :many
+SELECT id, created_at, name, email, password_hash, activated, version, locale, disabled
+FROM users
+WHERE ($1::text = '' OR name ILIKE '%' || $1 || '%' OR email ILIKE '%' || $1 || '%')
+ORDER BY id
+OFFSET $2 LIMIT $3
+`
+
+type GetUsersParams struct {
+	Search string
+	Offset int32
+	Limit  int32
+}
+
+func (q *Queries) GetUsers(ctx context.Context, arg GetUsersParams) ([]*User, error) {
+	rows, err := q.db.Query(ctx, getUsers, arg.Search, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Name,
+			&i.Email,
+			&i.PasswordHash,
+			&i.Activated,
+			&i.Version,
+			&i.Locale,
+			&i.Disabled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET name          = CASE WHEN $1::boolean THEN $2 ELSE name END,
     email         = CASE WHEN $3::boolean THEN $4 ELSE email END,
     password_hash = CASE WHEN $5::boolean THEN $6 ELSE password_hash END,
     activated     = CASE WHEN $7::boolean THEN $8 ELSE activated END,
+    disabled      = CASE WHEN $9::boolean THEN $10 ELSE disabled END,
     version       = version + 1
-WHERE id = $9
-  AND version = $10
-RETURNING id, created_at, name, email, password_hash, activated, version
+WHERE id = $11
+  AND version = $12
+RETURNING id, created_at, name, email, password_hash, activated, version, locale, disabled
 `
 
 type UpdateUserParams struct {
@@ -127,6 +216,8 @@ type UpdateUserParams struct {
 	PasswordHash       []byte
 	UpdateActivated    bool
 	Activated          bool
+	UpdateDisabled     bool
+	Disabled           bool
 	ID                 int64
 	Version            int32
 }
@@ -141,6 +232,8 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (*User,
 		arg.PasswordHash,
 		arg.UpdateActivated,
 		arg.Activated,
+		arg.UpdateDisabled,
+		arg.Disabled,
 		arg.ID,
 		arg.Version,
 	)
@@ -153,6 +246,8 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (*User,
 		&i.PasswordHash,
 		&i.Activated,
 		&i.Version,
+		&i.Locale,
+		&i.Disabled,
 	)
 	return &i, err
 }
@@ -0,0 +1,199 @@
+// Package bounce receives the bounce and complaint notifications of email providers and adds the addresses to the
+// suppression list, so we stop emailing addresses that hard bounce or report us as spam. It accepts a generic format,
+// {"type": "bounce" or "complaint", "email": "...", "reason": "..."} or an array of them, Amazon SES notifications,
+// delivered by SNS or posted directly, and SendGrid event webhooks.
+package bounce
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	Bounce    = "bounce"
+	Complaint = "complaint"
+
+	SourceGeneric  = "generic"
+	SourceSES      = "ses"
+	SourceSendGrid = "sendgrid"
+)
+
+var ErrUnknownFormat = errors.New("unknown notification format")
+
+type (
+	// Event is an address to suppress, Type is Bounce or Complaint.
+	Event struct {
+		Email  string
+		Type   string
+		Detail string
+	}
+	// Result is a parsed notification. SNS asks to confirm a subscription before it delivers notifications, it then
+	// sets SubscribeURL and has no events.
+	Result struct {
+		Source       string
+		SubscribeURL string
+		Events       []Event
+	}
+	genericEvent struct {
+		Type   string `json:"type"`
+		Email  string `json:"email"`
+		Reason string `json:"reason"`
+	}
+	snsMessage struct {
+		Type         string `json:"Type"`
+		Message      string `json:"Message"`
+		SubscribeURL string `json:"SubscribeURL"`
+	}
+	sesRecipient struct {
+		EmailAddress   string `json:"emailAddress"`
+		DiagnosticCode string `json:"diagnosticCode"`
+	}
+	sesNotification struct {
+		NotificationType string `json:"notificationType"`
+		EventType        string `json:"eventType"`
+		Bounce           struct {
+			BounceType        string         `json:"bounceType"`
+			BounceSubType     string         `json:"bounceSubType"`
+			BouncedRecipients []sesRecipient `json:"bouncedRecipients"`
+		} `json:"bounce"`
+		Complaint struct {
+			ComplaintFeedbackType string         `json:"complaintFeedbackType"`
+			ComplainedRecipients  []sesRecipient `json:"complainedRecipients"`
+		} `json:"complaint"`
+	}
+	sendGridEvent struct {
+		Email  string `json:"email"`
+		Event  string `json:"event"`
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	}
+)
+
+// Parse detects the format of body and returns the addresses to suppress. Soft bounces, deliveries and other events
+// are skipped.
+func Parse(body []byte) (*Result, error) {
+	body = bytes.TrimSpace(body)
+
+	if bytes.HasPrefix(body, []byte("[")) {
+		var raw []map[string]json.RawMessage
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnknownFormat, err) //nolint:errorlint
+		}
+
+		if len(raw) > 0 && raw[0]["event"] != nil {
+			return parseSendGrid(body)
+		}
+
+		return parseGeneric(body)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownFormat, err) //nolint:errorlint
+	}
+
+	switch {
+	case raw["Type"] != nil:
+		return parseSNS(body)
+	case raw["notificationType"] != nil || raw["eventType"] != nil:
+		return parseSES(body)
+	default:
+		return parseGeneric(append(append([]byte("["), body...), ']'))
+	}
+}
+
+func parseGeneric(body []byte) (*Result, error) {
+	var events []genericEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownFormat, err) //nolint:errorlint
+	}
+
+	result := &Result{Source: SourceGeneric}
+
+	for _, e := range events {
+		if e.Email == "" || (e.Type != Bounce && e.Type != Complaint) {
+			return nil, fmt.Errorf("%w: events need an email and a type of %s or %s", ErrUnknownFormat, Bounce, Complaint)
+		}
+
+		result.Events = append(result.Events, Event{Email: e.Email, Type: e.Type, Detail: e.Reason})
+	}
+
+	return result, nil
+}
+
+func parseSNS(body []byte) (*Result, error) {
+	var msg snsMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownFormat, err) //nolint:errorlint
+	}
+
+	switch msg.Type {
+	case "SubscriptionConfirmation":
+		return &Result{Source: SourceSES, SubscribeURL: msg.SubscribeURL}, nil
+	case "Notification":
+		return parseSES([]byte(msg.Message))
+	default:
+		return &Result{Source: SourceSES}, nil
+	}
+}
+
+func parseSES(body []byte) (*Result, error) {
+	var n sesNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownFormat, err) //nolint:errorlint
+	}
+
+	result := &Result{Source: SourceSES}
+
+	notificationType := n.NotificationType
+	if notificationType == "" {
+		notificationType = n.EventType
+	}
+
+	switch notificationType {
+	case "Bounce":
+		// Transient bounces such as a full mailbox may succeed later, Undetermined ones are not acted on either.
+		if n.Bounce.BounceType != "Permanent" {
+			return result, nil
+		}
+
+		for _, r := range n.Bounce.BouncedRecipients {
+			detail := n.Bounce.BounceSubType
+			if r.DiagnosticCode != "" {
+				detail += ": " + r.DiagnosticCode
+			}
+
+			result.Events = append(result.Events, Event{Email: r.EmailAddress, Type: Bounce, Detail: detail})
+		}
+	case "Complaint":
+		for _, r := range n.Complaint.ComplainedRecipients {
+			result.Events = append(result.Events, Event{Email: r.EmailAddress, Type: Complaint, Detail: n.Complaint.ComplaintFeedbackType})
+		}
+	}
+
+	return result, nil
+}
+
+func parseSendGrid(body []byte) (*Result, error) {
+	var events []sendGridEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownFormat, err) //nolint:errorlint
+	}
+
+	result := &Result{Source: SourceSendGrid}
+
+	for _, e := range events {
+		switch {
+		// SendGrid reports soft bounces as a bounce event of type blocked.
+		case e.Event == "bounce" && e.Type != "blocked":
+			result.Events = append(result.Events, Event{Email: e.Email, Type: Bounce, Detail: e.Reason})
+		case e.Event == "spamreport":
+			result.Events = append(result.Events, Event{Email: e.Email, Type: Complaint})
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package bounce_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/seanflannery10/core/internal/server/bounce"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Generic(t *testing.T) {
+	result, err := bounce.Parse([]byte(`{"type": "bounce", "email": "user@test.com", "reason": "mailbox does not exist"}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, bounce.SourceGeneric, result.Source)
+	assert.Equal(t, []bounce.Event{{Email: "user@test.com", Type: bounce.Bounce, Detail: "mailbox does not exist"}}, result.Events)
+
+	result, err = bounce.Parse([]byte(`[{"type": "bounce", "email": "a@test.com"}, {"type": "complaint", "email": "b@test.com"}]`))
+	require.NoError(t, err)
+	assert.Len(t, result.Events, 2)
+	assert.Equal(t, bounce.Complaint, result.Events[1].Type)
+
+	_, err = bounce.Parse([]byte(`{"type": "delivered", "email": "user@test.com"}`))
+	assert.ErrorIs(t, err, bounce.ErrUnknownFormat)
+
+	_, err = bounce.Parse([]byte(`not json`))
+	assert.ErrorIs(t, err, bounce.ErrUnknownFormat)
+}
+
+func TestParse_SES(t *testing.T) {
+	sns := func(message string) []byte {
+		body, err := json.Marshal(map[string]string{"Type": "Notification", "Message": message})
+		require.NoError(t, err)
+
+		return body
+	}
+
+	result, err := bounce.Parse(sns(`{"notificationType": "Bounce", "bounce": {"bounceType": "Permanent",
+		"bounceSubType": "General", "bouncedRecipients": [{"emailAddress": "user@test.com",
+		"diagnosticCode": "smtp; 550 5.1.1 user unknown"}]}}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, bounce.SourceSES, result.Source)
+	assert.Equal(t, []bounce.Event{
+		{Email: "user@test.com", Type: bounce.Bounce, Detail: "General: smtp; 550 5.1.1 user unknown"},
+	}, result.Events)
+
+	result, err = bounce.Parse(sns(`{"notificationType": "Bounce", "bounce": {"bounceType": "Transient",
+		"bouncedRecipients": [{"emailAddress": "user@test.com"}]}}`))
+	require.NoError(t, err)
+	assert.Empty(t, result.Events)
+
+	// Event publishing posts the event itself and names its type eventType.
+	result, err = bounce.Parse([]byte(`{"eventType": "Complaint", "complaint": {"complaintFeedbackType": "abuse",
+		"complainedRecipients": [{"emailAddress": "user@test.com"}]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []bounce.Event{{Email: "user@test.com", Type: bounce.Complaint, Detail: "abuse"}}, result.Events)
+
+	result, err = bounce.Parse([]byte(`{"Type": "SubscriptionConfirmation",
+		"SubscribeURL": "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription", result.SubscribeURL)
+	assert.Empty(t, result.Events)
+}
+
+func TestParse_SendGrid(t *testing.T) {
+	result, err := bounce.Parse([]byte(`[
+		{"email": "a@test.com", "event": "bounce", "type": "bounce", "reason": "550 5.1.1 user unknown"},
+		{"email": "b@test.com", "event": "bounce", "type": "blocked", "reason": "try again later"},
+		{"email": "c@test.com", "event": "spamreport"},
+		{"email": "d@test.com", "event": "delivered"}
+	]`))
+	require.NoError(t, err)
+
+	assert.Equal(t, bounce.SourceSendGrid, result.Source)
+	assert.Equal(t, []bounce.Event{
+		{Email: "a@test.com", Type: bounce.Bounce, Detail: "550 5.1.1 user unknown"},
+		{Email: "c@test.com", Type: bounce.Complaint},
+	}, result.Events)
+}
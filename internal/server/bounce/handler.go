@@ -0,0 +1,68 @@
+package bounce
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"golang.org/x/exp/slog"
+)
+
+const maxBodySize = 1 << 20
+
+// Handler suppresses the addresses of each notification posted to it in one transaction, a failed request is retried
+// by the provider. It must be mounted behind authentication, providers support credentials in the webhook URL.
+func Handler(pool *pgxpool.Pool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		result, err := Parse(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if result.SubscribeURL != "" {
+			// Confirming means fetching a URL taken from the request, an operator does that once per topic instead.
+			slog.WarnCtx(r.Context(), "sns subscription needs confirmation", "subscribe_url", result.SubscribeURL)
+		}
+
+		err = pgx.BeginFunc(r.Context(), pool, func(tx pgx.Tx) error {
+			q := data.New(tx)
+
+			for _, e := range result.Events {
+				suppression := data.SuppressEmailParams{Email: e.Email, Reason: e.Type, Source: result.Source, Detail: e.Detail}
+
+				if err := logic.SuppressEmail(r.Context(), q, suppression); err != nil {
+					return fmt.Errorf("failed suppress %s: %w", e.Type, err)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			slog.ErrorCtx(r.Context(), "unable to record email suppressions", "error", errors.Wrap(err, result.Source))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
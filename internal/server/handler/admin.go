@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/server/logic"
+)
+
+func (s *Handler) AdminGetAuditEvents(ctx context.Context, params api.AdminGetAuditEventsParams) (*api.AuditEventsResponse, error) {
+	filter := logic.AuditEventFilter{UserID: params.UserID, EventType: params.EventType, Since: params.Since, Until: params.Until}
+
+	auditEventsResponse, err := logic.AdminGetAuditEvents(ctx, s.Queries, filter, params.Page.Value, params.PageSize.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed admin get audit events")
+	}
+
+	return auditEventsResponse, nil
+}
+
+func (s *Handler) AdminGetUsers(ctx context.Context, params api.AdminGetUsersParams) (*api.AdminUsersResponse, error) {
+	adminUsersResponse, err := logic.AdminGetUsers(ctx, s.Queries, params.Search.Value, params.Page.Value, params.PageSize.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed admin get users")
+	}
+
+	return adminUsersResponse, nil
+}
+
+func (s *Handler) AdminGetUser(ctx context.Context, params api.AdminGetUserParams) (*api.AdminUserResponse, error) {
+	adminUserResponse, err := logic.AdminGetUser(ctx, s.Queries, params.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed admin get user")
+	}
+
+	return adminUserResponse, nil
+}
+
+func (s *Handler) AdminDeactivateUser(ctx context.Context, params api.AdminDeactivateUserParams) (*api.AdminUserResponse, error) {
+	adminUserResponse, err := logic.AdminDeactivateUser(ctx, s.Queries, params.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed admin deactivate user")
+	}
+
+	return adminUserResponse, nil
+}
+
+func (s *Handler) AdminReactivateUser(ctx context.Context, params api.AdminReactivateUserParams) (*api.AdminUserResponse, error) {
+	adminUserResponse, err := logic.AdminReactivateUser(ctx, s.Queries, params.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed admin reactivate user")
+	}
+
+	return adminUserResponse, nil
+}
+
+func (s *Handler) AdminForcePasswordReset(ctx context.Context, params api.AdminForcePasswordResetParams) (*api.AcceptanceResponse, error) {
+	if _, err := logic.AdminForcePasswordReset(ctx, s.Queries, params.ID); err != nil {
+		return nil, errors.Wrap(err, "failed admin force password reset")
+	}
+
+	acceptanceResponse := &api.AcceptanceResponse{Message: "password reset email sent"}
+
+	return acceptanceResponse, nil
+}
+
+func (s *Handler) AdminGetUserSessions(ctx context.Context, params api.AdminGetUserSessionsParams) (*api.SessionsResponse, error) {
+	sessionsResponse, err := logic.AdminGetUserSessions(ctx, s.Queries, params.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed admin get user sessions")
+	}
+
+	return sessionsResponse, nil
+}
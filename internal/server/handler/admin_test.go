@@ -0,0 +1,126 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testManagedUserEmail = "managed@test.com"
+	testManagedUserID    = 5
+	testUserIDMissing    = 500
+)
+
+func TestAdminGetUsers_Success(t *testing.T) {
+	params := api.AdminGetUsersParams{
+		Search:   api.OptString{Value: "managed", Set: true},
+		Page:     api.OptInt32{Value: page, Set: true},
+		PageSize: api.OptInt32{Value: pageSize, Set: true},
+	}
+
+	response, err := newTestHandler(t).AdminGetUsers(context.Background(), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Len(t, response.Users, 1)
+	assert.Equal(t, testManagedUserEmail, response.Users[0].Email)
+	assert.Equal(t, int64(1), response.Metadata.TotalRecords)
+}
+
+func TestAdminGetUser_Success(t *testing.T) {
+	params := api.AdminGetUserParams{ID: testManagedUserID}
+
+	response, err := newTestHandler(t).AdminGetUser(context.Background(), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, testManagedUserEmail, response.Email)
+	assert.True(t, response.Activated)
+}
+
+func TestAdminGetUser_NotFound(t *testing.T) {
+	params := api.AdminGetUserParams{ID: testUserIDMissing}
+
+	response, err := newTestHandler(t).AdminGetUser(context.Background(), params)
+	if !errors.Is(err, logic.ErrUserNotFound) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestAdminDeactivateUser_Success(t *testing.T) {
+	params := api.AdminDeactivateUserParams{ID: testManagedUserID}
+
+	response, err := newTestHandler(t).AdminDeactivateUser(context.Background(), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.True(t, response.Disabled)
+}
+
+func TestAdminReactivateUser_Success(t *testing.T) {
+	params := api.AdminReactivateUserParams{ID: testManagedUserID}
+
+	response, err := newTestHandler(t).AdminReactivateUser(context.Background(), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.True(t, response.Activated)
+	assert.False(t, response.Disabled)
+}
+
+func TestAdminForcePasswordReset_Success(t *testing.T) {
+	params := api.AdminForcePasswordResetParams{ID: testManagedUserID}
+
+	expected := &api.AcceptanceResponse{Message: "password reset email sent"}
+
+	response, err := newTestHandler(t).AdminForcePasswordReset(context.Background(), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, expected, response)
+}
+
+func TestAdminGetUserSessions_Success(t *testing.T) {
+	params := api.AdminGetUserSessionsParams{ID: testUserID}
+
+	response, err := newTestHandler(t).AdminGetUserSessions(context.Background(), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Len(t, response.Sessions, 1)
+	assert.Equal(t, int64(testSessionID), response.Sessions[0].ID)
+}
+
+func TestCheckUserPermission(t *testing.T) {
+	q := newTestHandler(t).Queries
+
+	// An operation missing from the permission map is denied, so a new operation is never exposed by accident.
+	err := logic.CheckUserPermission(context.Background(), q, "NotAnOperation", testManagedUserID)
+	assert.ErrorIs(t, err, logic.ErrPermissionDenied)
+
+	err = logic.CheckUserPermission(context.Background(), q, "GetCurrentUser", testManagedUserID)
+	assert.NoError(t, err)
+
+	err = logic.CheckAPIKeyPermission("GetCurrentUser", []string{string(api.PermissionMessagesRead), string(api.PermissionMessagesWrite)})
+	assert.ErrorIs(t, err, logic.ErrPermissionDenied)
+}
+
+func TestGrantUserRole_UnknownRole(t *testing.T) {
+	err := logic.GrantUserRole(context.Background(), newTestHandler(t).Queries, testManagedUserEmail, "superuser")
+	assert.ErrorIs(t, err, logic.ErrRoleNotFound)
+}
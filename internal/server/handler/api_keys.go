@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/utils"
+)
+
+func (s *Handler) GetUserAPIKeys(ctx context.Context) (*api.APIKeysResponse, error) {
+	user := utils.ContextGetUser(ctx)
+
+	apiKeysResponse, err := logic.GetUserAPIKeys(ctx, s.Queries, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get user api keys")
+	}
+
+	return apiKeysResponse, nil
+}
+
+func (s *Handler) NewAPIKey(ctx context.Context, req *api.APIKeyRequest) (*api.APIKeyResponse, error) {
+	user := utils.ContextGetUser(ctx)
+
+	apiKeyResponse, err := logic.NewAPIKey(ctx, s.Queries, req, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed new api key")
+	}
+
+	return apiKeyResponse, nil
+}
+
+func (s *Handler) DeleteAPIKey(ctx context.Context, params api.DeleteAPIKeyParams) (*api.AcceptanceResponse, error) {
+	user := utils.ContextGetUser(ctx)
+
+	acceptanceResponse, err := logic.DeleteAPIKey(ctx, s.Queries, params.ID, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed delete api key")
+	}
+
+	return acceptanceResponse, nil
+}
@@ -0,0 +1,90 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAPIKeyID        = 1
+	testAPIKeyIDMissing = 500
+	testAPIKeyName      = "ci"
+)
+
+func TestNewAPIKey_Success(t *testing.T) {
+	request := &api.APIKeyRequest{
+		Name:        testAPIKeyName,
+		Permissions: []api.Permission{api.PermissionMessagesRead},
+	}
+
+	response, err := newTestHandler(t).NewAPIKey(ctxWithTestUser(t), request)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, int64(testAPIKeyID), response.ID)
+	assert.Equal(t, testAPIKeyName, response.Name)
+	assert.Equal(t, request.Permissions, response.Permissions)
+	assert.True(t, response.Key.Set)
+	assert.Contains(t, response.Key.Value, logic.APIKeyPrefix)
+	assert.False(t, response.Expiry.Set)
+}
+
+func TestNewAPIKey_InvalidExpiry(t *testing.T) {
+	request := &api.APIKeyRequest{
+		Name:        testAPIKeyName,
+		Permissions: []api.Permission{api.PermissionMessagesRead},
+		Expiry:      api.OptDateTime{Value: time.Now().Add(-time.Hour), Set: true},
+	}
+
+	response, err := newTestHandler(t).NewAPIKey(ctxWithTestUser(t), request)
+	if !errors.Is(err, logic.ErrInvalidExpiry) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestGetUserAPIKeys_Success(t *testing.T) {
+	response, err := newTestHandler(t).GetUserAPIKeys(ctxWithTestUser(t))
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Len(t, response.APIKeys, 1)
+	assert.Equal(t, testAPIKeyName, response.APIKeys[0].Name)
+	assert.False(t, response.APIKeys[0].Key.Set)
+}
+
+func TestDeleteAPIKey_Success(t *testing.T) {
+	params := api.DeleteAPIKeyParams{ID: testAPIKeyID}
+
+	expected := &api.AcceptanceResponse{Message: "api key revoked"}
+
+	response, err := newTestHandler(t).DeleteAPIKey(ctxWithTestUser(t), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, expected, response)
+}
+
+func TestDeleteAPIKey_NotFound(t *testing.T) {
+	params := api.DeleteAPIKeyParams{ID: testAPIKeyIDMissing}
+
+	response, err := newTestHandler(t).DeleteAPIKey(ctxWithTestUser(t), params)
+	if !errors.Is(err, logic.ErrAPIKeyNotFound) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
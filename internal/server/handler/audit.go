@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/utils"
+)
+
+func (s *Handler) GetUserAuditEvents(ctx context.Context, params api.GetUserAuditEventsParams) (*api.AuditEventsResponse, error) {
+	user := utils.ContextGetUser(ctx)
+
+	auditEventsResponse, err := logic.GetUserAuditEvents(ctx, s.Queries, user.ID, params.Page.Value, params.PageSize.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get user audit events")
+	}
+
+	return auditEventsResponse, nil
+}
@@ -0,0 +1,79 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserAuditEvents_Success(t *testing.T) {
+	params := api.GetUserAuditEventsParams{
+		Page:     api.OptInt32{Value: page, Set: true},
+		PageSize: api.OptInt32{Value: pageSize, Set: true},
+	}
+
+	response, err := newTestHandler(t).GetUserAuditEvents(ctxWithTestUser(t), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.NotEmpty(t, response.Events)
+
+	for _, v := range response.Events {
+		assert.Equal(t, api.OptInt64{Value: testUserID, Set: true}, v.UserID)
+	}
+}
+
+func TestGetUserAuditEvents_PageValueToHigh(t *testing.T) {
+	params := api.GetUserAuditEventsParams{
+		Page:     api.OptInt32{Value: 500, Set: true},
+		PageSize: api.OptInt32{Value: pageSize, Set: true},
+	}
+
+	response, err := newTestHandler(t).GetUserAuditEvents(ctxWithTestUser(t), params)
+	if !errors.Is(err, pagination.ErrPageValueToHigh) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestAdminGetAuditEvents_Success(t *testing.T) {
+	params := api.AdminGetAuditEventsParams{
+		UserID:    api.OptInt64{Value: testManagedUserID, Set: true},
+		EventType: api.OptAuditEventType{Value: api.AuditEventTypeUserRegistered, Set: true},
+		Page:      api.OptInt32{Value: page, Set: true},
+		PageSize:  api.OptInt32{Value: pageSize, Set: true},
+	}
+
+	response, err := newTestHandler(t).AdminGetAuditEvents(context.Background(), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Len(t, response.Events, 1)
+	assert.Equal(t, api.AuditEventTypeUserRegistered, response.Events[0].EventType)
+	assert.Equal(t, int64(1), response.Metadata.TotalRecords)
+}
+
+func TestAdminGetAuditEvents_Details(t *testing.T) {
+	params := api.AdminGetAuditEventsParams{
+		EventType: api.OptAuditEventType{Value: api.AuditEventTypeLoginFailed, Set: true},
+		Page:      api.OptInt32{Value: page, Set: true},
+		PageSize:  api.OptInt32{Value: pageSize, Set: true},
+	}
+
+	response, err := newTestHandler(t).AdminGetAuditEvents(context.Background(), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.NotEmpty(t, response.Events)
+	assert.Contains(t, response.Events[len(response.Events)-1].Details, "email")
+}
@@ -4,60 +4,86 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/seanflannery10/core/internal/generated/api"
 	"github.com/seanflannery10/core/internal/generated/data"
 	"github.com/seanflannery10/core/internal/server/logic"
-	"github.com/seanflannery10/core/internal/shared/mailer"
+	"github.com/seanflannery10/core/internal/shared/keyring"
+	"github.com/seanflannery10/core/internal/shared/locale"
 	"github.com/seanflannery10/core/internal/shared/pagination"
+	"github.com/seanflannery10/core/internal/shared/password"
 	"golang.org/x/exp/slog"
 )
 
 var _ api.Handler = (*Handler)(nil)
 
 type Handler struct {
-	Mailer  mailer.Mailer
 	Queries *data.Queries
-	Secret  []byte
+	// Pool begins the transactions of operations that must write all of their rows or none.
+	Pool *pgxpool.Pool
+	// Keyring seals the refresh token cookie.
+	Keyring *keyring.Keyring
+	// CookieTTL is how long the refresh token cookie lives, it defaults to the default refresh token TTL.
+	CookieTTL time.Duration
 }
 
-func (s *Handler) NewError(_ context.Context, err error) *api.ErrorResponseStatusCode {
+func (s *Handler) NewError(ctx context.Context, err error) *api.ErrorResponseStatusCode {
 	var (
 		code       int
 		errMessage = errors.Unwrap(err).Error()
+		policyErr  *password.PolicyError
+		rule       api.OptErrorResponseRule
 
+		accountLocked        = errors.Is(err, logic.ErrAccountLocked)
 		activationRequired   = errors.Is(err, logic.ErrActivationRequired)
+		apiKeyNotFound       = errors.Is(err, logic.ErrAPIKeyNotFound)
 		editConflict         = errors.Is(err, logic.ErrEditConflict)
 		emailNotFound        = errors.Is(err, logic.ErrEmailNotFound)
 		invalidCredentials   = errors.Is(err, logic.ErrInvalidCredentials)
+		invalidExpiry        = errors.Is(err, logic.ErrInvalidExpiry)
+		invalidLocale        = errors.Is(err, locale.ErrInvalidLocale)
 		invalidToken         = errors.Is(err, logic.ErrInvalidToken)
 		messageNotFound      = errors.Is(err, logic.ErrMessageNotFound)
+		passwordPolicy       = errors.As(err, &policyErr)
 		pageValueToHigh      = errors.Is(err, pagination.ErrPageValueToHigh)
 		reusedRefreshToken   = errors.Is(err, logic.ErrReusedRefreshToken)
+		sessionNotFound      = errors.Is(err, logic.ErrSessionNotFound)
 		userAlreadyActivated = errors.Is(err, logic.ErrUserAlreadyActivated)
+		userDisabled         = errors.Is(err, logic.ErrUserDisabled)
 		userExists           = errors.Is(err, logic.ErrUserExists)
+		userNotFound         = errors.Is(err, logic.ErrUserNotFound)
 	)
 
 	switch {
 	case invalidCredentials, reusedRefreshToken:
 		code = http.StatusUnauthorized
-	case emailNotFound, messageNotFound:
+	case apiKeyNotFound, emailNotFound, messageNotFound, sessionNotFound, userNotFound:
 		code = http.StatusNotFound
+	case userDisabled:
+		code = http.StatusForbidden
 	case editConflict:
 		code = http.StatusConflict
-	case activationRequired, invalidToken, pageValueToHigh, userAlreadyActivated, userExists:
+	case accountLocked:
+		code = http.StatusLocked
+	case activationRequired, invalidExpiry, invalidLocale, invalidToken, pageValueToHigh, userAlreadyActivated, userExists:
 		code = http.StatusUnprocessableEntity
+	case passwordPolicy:
+		code = http.StatusUnprocessableEntity
+		errMessage = policyErr.Message
+		rule = api.NewOptErrorResponseRule(api.ErrorResponseRule(policyErr.Rule))
 	default:
-		slog.Error("server error", "error", err)
+		slog.ErrorCtx(ctx, "server error", "error", err)
 
 		code = http.StatusInternalServerError
 		errMessage = logic.ErrServerError.Error()
 	}
 
-	return &api.ErrorResponseStatusCode{StatusCode: code, Response: api.ErrorResponse{Error: errMessage}}
+	return &api.ErrorResponseStatusCode{StatusCode: code, Response: api.ErrorResponse{Error: errMessage, Rule: rule}}
 }
 
 func ErrorHandler(_ context.Context, w http.ResponseWriter, _ *http.Request, err error) {
@@ -68,6 +94,10 @@ func ErrorHandler(_ context.Context, w http.ResponseWriter, _ *http.Request, err
 		errMessage = "missing security token or cookie"
 	}
 
+	if errors.Is(err, logic.ErrPermissionDenied) || errors.Is(err, logic.ErrUserDisabled) {
+		code = http.StatusForbidden
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
 
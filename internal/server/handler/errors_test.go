@@ -2,6 +2,7 @@ package handler_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,7 +12,9 @@ import (
 	"github.com/seanflannery10/core/internal/generated/api"
 	"github.com/seanflannery10/core/internal/server/handler"
 	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/locale"
 	"github.com/seanflannery10/core/internal/shared/pagination"
+	"github.com/seanflannery10/core/internal/shared/password"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,12 +25,19 @@ func TestNewError(t *testing.T) {
 	}{
 		{Error: logic.ErrInvalidCredentials, StatusCode: http.StatusUnauthorized},
 		{Error: logic.ErrReusedRefreshToken, StatusCode: http.StatusUnauthorized},
+		{Error: logic.ErrAPIKeyNotFound, StatusCode: http.StatusNotFound},
 		{Error: logic.ErrEmailNotFound, StatusCode: http.StatusNotFound},
 		{Error: logic.ErrMessageNotFound, StatusCode: http.StatusNotFound},
+		{Error: logic.ErrSessionNotFound, StatusCode: http.StatusNotFound},
+		{Error: logic.ErrUserNotFound, StatusCode: http.StatusNotFound},
+		{Error: logic.ErrUserDisabled, StatusCode: http.StatusForbidden},
 		{Error: logic.ErrEditConflict, StatusCode: http.StatusConflict},
+		{Error: logic.ErrAccountLocked, StatusCode: http.StatusLocked},
 		{Error: logic.ErrActivationRequired, StatusCode: http.StatusUnprocessableEntity},
+		{Error: logic.ErrInvalidExpiry, StatusCode: http.StatusUnprocessableEntity},
 		{Error: logic.ErrInvalidToken, StatusCode: http.StatusUnprocessableEntity},
 		{Error: pagination.ErrPageValueToHigh, StatusCode: http.StatusUnprocessableEntity},
+		{Error: locale.ErrInvalidLocale, StatusCode: http.StatusUnprocessableEntity},
 		{Error: logic.ErrUserAlreadyActivated, StatusCode: http.StatusUnprocessableEntity},
 		{Error: logic.ErrUserExists, StatusCode: http.StatusUnprocessableEntity},
 		{Error: logic.ErrServerError, StatusCode: http.StatusInternalServerError},
@@ -46,6 +56,21 @@ func TestNewError(t *testing.T) {
 	}
 }
 
+func TestNewError_PasswordPolicy(t *testing.T) {
+	policyErr := &password.PolicyError{Rule: password.RuleTooWeak, Message: "password is too easy to guess"}
+
+	expected := &api.ErrorResponseStatusCode{
+		StatusCode: http.StatusUnprocessableEntity,
+		Response: api.ErrorResponse{
+			Error: policyErr.Message,
+			Rule:  api.NewOptErrorResponseRule(api.ErrorResponseRuleTooWeak),
+		},
+	}
+
+	response := newTestHandler(t).NewError(context.Background(), errors.Wrap(fmt.Errorf("failed set password: %w", policyErr), "testing"))
+	assert.Equal(t, expected, response)
+}
+
 func TestErrorHandler(t *testing.T) {
 	testCases := []struct {
 		Error      error
@@ -53,6 +78,8 @@ func TestErrorHandler(t *testing.T) {
 	}{
 		{Error: logic.ErrServerError, StatusCode: http.StatusInternalServerError},
 		{Error: ogenerrors.ErrSecurityRequirementIsNotSatisfied, StatusCode: http.StatusInternalServerError},
+		{Error: logic.ErrPermissionDenied, StatusCode: http.StatusForbidden},
+		{Error: logic.ErrUserDisabled, StatusCode: http.StatusForbidden},
 	}
 
 	for _, tc := range testCases {
@@ -1,49 +1,37 @@
 package handler
 
 import (
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
-	"encoding/base64"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/keyring"
 )
 
 var errValueTooLong = errors.New("cookie value too long")
 
 const (
+	// CookieRefreshToken is the name of the cookie holding the sealed refresh token.
+	CookieRefreshToken = "core_refresh_token"
 	cookieMaxSize      = 4096
-	cookieRefreshToken = "core_refresh_token"
-	cookieTTL          = 7 * 24 * 60 * 60
 )
 
-func newCookie(name, value string, ttl int, secret []byte) (http.Cookie, error) {
-	cookie := http.Cookie{Name: name, Value: value, Path: "/", MaxAge: ttl, HttpOnly: true, Secure: true, SameSite: http.SameSiteLaxMode}
-
-	block, err := aes.NewCipher(secret)
-	if err != nil {
-		return http.Cookie{}, fmt.Errorf("failed new cipher: %w", err)
-	}
-
-	aesGCM, err := cipher.NewGCM(block)
-	if err != nil {
-		return http.Cookie{}, fmt.Errorf("failed new gcm: %w", err)
+func (s *Handler) cookieTTL() int {
+	if s.CookieTTL == 0 {
+		return int(logic.DefaultTokenTTL.Refresh.Seconds())
 	}
 
-	nonce := make([]byte, aesGCM.NonceSize())
+	return int(s.CookieTTL.Seconds())
+}
 
-	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
-		return http.Cookie{}, fmt.Errorf("failed read full: %w", err)
+func newCookie(name, value string, ttl int, keys *keyring.Keyring) (http.Cookie, error) {
+	sealed, err := keys.Seal(name, value)
+	if err != nil {
+		return http.Cookie{}, fmt.Errorf("failed seal: %w", err)
 	}
 
-	plaintext := fmt.Sprintf("%s:%s", cookie.Name, cookie.Value)
-
-	encryptedValue := aesGCM.Seal(nonce, nonce, []byte(plaintext), nil)
-
-	cookie.Value = base64.URLEncoding.EncodeToString(encryptedValue)
+	cookie := http.Cookie{Name: name, Value: sealed, Path: "/", MaxAge: ttl, HttpOnly: true, Secure: true, SameSite: http.SameSiteLaxMode}
 
 	if len(cookie.String()) > cookieMaxSize {
 		return http.Cookie{}, fmt.Errorf("failed length check: %w", errValueTooLong)
@@ -9,7 +9,9 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/seanflannery10/core/internal/generated/data"
 	"github.com/seanflannery10/core/internal/server/handler"
-	"github.com/seanflannery10/core/internal/shared/mailer"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/keyring"
+	"github.com/seanflannery10/core/internal/shared/password"
 	"github.com/seanflannery10/core/internal/shared/utils"
 )
 
@@ -18,6 +20,8 @@ const (
 	secretKey  = "ff2636f4a5abf829042c96d38caa8007427773980fddab20fd7c43d93dc186ca" //nolint:gosec
 )
 
+var testConfig = logic.Config{PasswordHasher: password.TestArgon2id}
+
 func newTestHandler(t *testing.T) *handler.Handler {
 	t.Helper()
 
@@ -26,21 +30,56 @@ func newTestHandler(t *testing.T) *handler.Handler {
 		t.Fatalf(unexpectedError, errors.Wrap(err, "filed decode string"))
 	}
 
-	dbpool, err := pgxpool.New(context.Background(), connString)
+	keys, err := keyring.New(secret)
 	if err != nil {
-		t.Fatalf(unexpectedError, errors.Wrap(err, "filed new pool"))
+		t.Fatalf(unexpectedError, errors.Wrap(err, "filed new keyring"))
 	}
 
-	mail, err := mailer.New(mailer.SMTP{Host: "localhost", Port: 2525, Sender: "Test <no-reply@testdomain.com>", Test: true})
-	if err != nil {
-		t.Fatalf(unexpectedError, errors.Wrap(err, "filed new mailer"))
-	}
+	testConfig.Keyring = keys
+	logic.Configure(testConfig)
+
+	dbpool := newTestPool(t)
 
 	return &handler.Handler{
 		Queries: data.New(dbpool),
-		Mailer:  mail,
-		Secret:  secret,
+		Pool:    dbpool,
+		Keyring: keys,
+	}
+}
+
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dbpool, err := pgxpool.New(context.Background(), connString)
+	if err != nil {
+		t.Fatalf(unexpectedError, errors.Wrap(err, "filed new pool"))
 	}
+
+	return dbpool
+}
+
+// countRows runs a SELECT count(*) query.
+func countRows(t *testing.T, dbpool *pgxpool.Pool, query string, args ...any) int {
+	t.Helper()
+
+	var n int
+
+	if err := dbpool.QueryRow(context.Background(), query, args...).Scan(&n); err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	return n
+}
+
+// withPrivacyMode turns privacy mode on until the test ends.
+func withPrivacyMode(t *testing.T) {
+	t.Helper()
+
+	cfg := testConfig
+	cfg.PrivacyMode = true
+
+	logic.Configure(cfg)
+	t.Cleanup(func() { logic.Configure(testConfig) })
 }
 
 func ctxWithTestUser(t *testing.T) context.Context {
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/utils"
+)
+
+func (s *Handler) GetUserSessions(ctx context.Context) (*api.SessionsResponse, error) {
+	user := utils.ContextGetUser(ctx)
+
+	sessionsResponse, err := logic.GetUserSessions(ctx, s.Queries, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get user sessions")
+	}
+
+	return sessionsResponse, nil
+}
+
+func (s *Handler) DeleteSession(ctx context.Context, params api.DeleteSessionParams) (*api.AcceptanceResponse, error) {
+	user := utils.ContextGetUser(ctx)
+
+	acceptanceResponse, err := logic.DeleteSession(ctx, s.Queries, params.ID, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed delete session")
+	}
+
+	return acceptanceResponse, nil
+}
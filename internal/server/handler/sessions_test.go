@@ -0,0 +1,52 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testSessionID        = 1
+	testSessionIDMissing = 500
+)
+
+func TestGetUserSessions_Success(t *testing.T) {
+	response, err := newTestHandler(t).GetUserSessions(ctxWithTestUser(t))
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Len(t, response.Sessions, 1)
+	assert.Equal(t, int64(testSessionID), response.Sessions[0].ID)
+	assert.Equal(t, "127.0.0.1", response.Sessions[0].IPAddress)
+}
+
+func TestDeleteSession_Success(t *testing.T) {
+	params := api.DeleteSessionParams{ID: testSessionID}
+
+	expected := &api.AcceptanceResponse{Message: "session revoked"}
+
+	response, err := newTestHandler(t).DeleteSession(ctxWithTestUser(t), params)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, expected, response)
+}
+
+func TestDeleteSession_NotFound(t *testing.T) {
+	params := api.DeleteSessionParams{ID: testSessionIDMissing}
+
+	response, err := newTestHandler(t).DeleteSession(ctxWithTestUser(t), params)
+	if !errors.Is(err, logic.ErrSessionNotFound) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
@@ -9,30 +9,49 @@ import (
 	"github.com/seanflannery10/core/internal/shared/utils"
 )
 
-func (s *Handler) NewActivationToken(ctx context.Context, req *api.UserEmailRequest) (*api.TokenResponse, error) {
+func (s *Handler) NewActivationToken(ctx context.Context, req *api.UserEmailRequest) (api.NewActivationTokenRes, error) {
 	activationToken, err := logic.NewActivationToken(ctx, s.Queries, req.Email)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed new activation token")
 	}
 
-	if err = s.Mailer.Send(req.Email, "token_activation.tmpl", map[string]any{"activationToken": activationToken.Token}); err != nil {
-		return nil, errors.Wrap(err, "failed send activation token email")
-	}
-
 	return activationToken, nil
 }
 
-func (s *Handler) NewPasswordResetToken(ctx context.Context, req *api.UserEmailRequest) (*api.TokenResponse, error) {
+func (s *Handler) NewPasswordResetToken(ctx context.Context, req *api.UserEmailRequest) (api.NewPasswordResetTokenRes, error) {
 	passwordResetToken, err := logic.NewPasswordResetToken(ctx, s.Queries, req.Email)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed new password reset token")
 	}
 
-	if err = s.Mailer.Send(req.Email, "token_password_reset.tmpl", map[string]any{"passwordResetToken": passwordResetToken.Token}); err != nil {
-		return nil, errors.Wrap(err, "failed send password reset token email")
+	return passwordResetToken, nil
+}
+
+func (s *Handler) NewMagicLinkToken(ctx context.Context, req *api.UserEmailRequest) (*api.AcceptanceResponse, error) {
+	if _, err := logic.NewMagicLinkToken(ctx, s.Queries, req.Email); err != nil {
+		return nil, errors.Wrap(err, "failed new magic link token")
 	}
 
-	return passwordResetToken, nil
+	acceptanceResponse := &api.AcceptanceResponse{Message: "sign in link sent"}
+
+	return acceptanceResponse, nil
+}
+
+func (s *Handler) ExchangeMagicLinkToken(ctx context.Context, req *api.TokenRequest) (*api.TokenResponseHeaders, error) {
+	refreshToken, accessToken, err := logic.ExchangeMagicLinkToken(ctx, s.Queries, req.Token)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed exchange magic link token")
+	}
+
+	cookie, err := newCookie(CookieRefreshToken, refreshToken.Token, s.cookieTTL(), s.Keyring)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed new refresh token cookie")
+	}
+
+	optString := api.OptString{Value: cookie.String(), Set: true}
+	tokenResponseHeaders := &api.TokenResponseHeaders{SetCookie: optString, Response: *accessToken}
+
+	return tokenResponseHeaders, nil
 }
 
 func (s *Handler) NewRefreshToken(ctx context.Context, req *api.UserLoginRequest) (*api.TokenResponseHeaders, error) {
@@ -41,7 +60,7 @@ func (s *Handler) NewRefreshToken(ctx context.Context, req *api.UserLoginRequest
 		return nil, errors.Wrap(err, "failed new refresh token")
 	}
 
-	cookie, err := newCookie(cookieRefreshToken, refreshToken.Token, cookieTTL, s.Secret)
+	cookie, err := newCookie(CookieRefreshToken, refreshToken.Token, s.cookieTTL(), s.Keyring)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed new refresh token cookie")
 	}
@@ -60,7 +79,7 @@ func (s *Handler) NewAccessToken(ctx context.Context) (*api.TokenResponseHeaders
 		return nil, errors.Wrap(err, "failed new access token")
 	}
 
-	cookie, err := newCookie(cookieRefreshToken, refreshToken.Token, cookieTTL, s.Secret)
+	cookie, err := newCookie(CookieRefreshToken, refreshToken.Token, s.cookieTTL(), s.Keyring)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed new access token cookie")
 	}
@@ -14,8 +14,16 @@ import (
 )
 
 const (
-	tokenLength  = 26
-	invalidToken = "token value is not valid"
+	tokenLength            = 26
+	invalidToken           = "token value is not valid"
+	testMagicLinkUserEmail = "magic@test.com"
+	testMagicLinkUserID    = 7
+
+	// testDisabledUserEmail is a user an administrator deactivated, before they ever activated their account.
+	testDisabledUserEmail       = "disabled@test.com"
+	testDisabledActivationToken = "DISABLEDACTIVATIONDISABLED"
+	testDisabledMagicLinkToken  = "DISABLEDMAGICLINKDISABLEDM"
+	testDisabledPasswordReset   = "DISABLEDPASSWORDRESETDISAB"
 )
 
 func TestNewActivationToken_Success(t *testing.T) {
@@ -28,11 +36,16 @@ func TestNewActivationToken_Success(t *testing.T) {
 		t.Fatalf(unexpectedError, err)
 	}
 
-	assert.Equal(t, logic.ScopeActivation, response.Scope)
-	assert.Equal(t, tokenLength, len(response.Token))
-	assert.IsType(t, time.Time{}, response.Expiry)
+	activationToken, ok := response.(*api.TokenResponse)
+	if !ok {
+		t.Fatal(unexpectedResponse)
+	}
 
-	if matches := regexp.MustCompile(`^([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{2}==)?$`).MatchString(response.Token); matches {
+	assert.Equal(t, logic.ScopeActivation, activationToken.Scope)
+	assert.Equal(t, tokenLength, len(activationToken.Token))
+	assert.IsType(t, time.Time{}, activationToken.Expiry)
+
+	if matches := regexp.MustCompile(`^([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{2}==)?$`).MatchString(activationToken.Token); matches {
 		t.Fatal(invalidToken)
 	}
 }
@@ -52,6 +65,186 @@ func TestNewActivationToken_NotFound(t *testing.T) {
 	}
 }
 
+func TestNewActivationToken_Disabled(t *testing.T) {
+	request := &api.UserEmailRequest{
+		Email: testDisabledUserEmail,
+	}
+
+	response, err := newTestHandler(t).NewActivationToken(context.Background(), request)
+	if !errors.Is(err, logic.ErrUserDisabled) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestNewActivationToken_PrivacyMode(t *testing.T) {
+	h := newTestHandler(t)
+	withPrivacyMode(t)
+
+	// Unknown, activated and unactivated emails are all answered the same, only the email tells them apart.
+	var responses []api.NewActivationTokenRes
+
+	for _, email := range []string{"notfound@test.com", "activated@test.com", "unactivated@test.com"} {
+		response, err := h.NewActivationToken(context.Background(), &api.UserEmailRequest{Email: email})
+		if err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		assert.IsType(t, &api.AcceptanceResponse{}, response)
+
+		responses = append(responses, response)
+	}
+
+	assert.Equal(t, responses[0], responses[1])
+	assert.Equal(t, responses[0], responses[2])
+}
+
+func TestNewMagicLinkToken_Success(t *testing.T) {
+	request := &api.UserEmailRequest{
+		Email: testMagicLinkUserEmail,
+	}
+
+	expected := &api.AcceptanceResponse{Message: "sign in link sent"}
+
+	response, err := newTestHandler(t).NewMagicLinkToken(context.Background(), request)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, expected, response)
+}
+
+func TestNewMagicLinkToken_NotFound(t *testing.T) {
+	request := &api.UserEmailRequest{
+		Email: "notfound@test.com",
+	}
+
+	response, err := newTestHandler(t).NewMagicLinkToken(context.Background(), request)
+	if !errors.Is(err, logic.ErrEmailNotFound) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestNewMagicLinkToken_Disabled(t *testing.T) {
+	request := &api.UserEmailRequest{
+		Email: testDisabledUserEmail,
+	}
+
+	response, err := newTestHandler(t).NewMagicLinkToken(context.Background(), request)
+	if !errors.Is(err, logic.ErrUserDisabled) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestNewMagicLinkToken_PrivacyMode(t *testing.T) {
+	h := newTestHandler(t)
+	withPrivacyMode(t)
+
+	expected := &api.AcceptanceResponse{Message: "sign in link sent"}
+
+	response, err := h.NewMagicLinkToken(context.Background(), &api.UserEmailRequest{Email: "notfound@test.com"})
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, expected, response)
+}
+
+func TestExchangeMagicLinkToken_Success(t *testing.T) {
+	request := &api.TokenRequest{Token: "MAGICLINKMAGICLINKMAGICLIN"}
+
+	h := newTestHandler(t)
+
+	tokenResponseHeaders, err := h.ExchangeMagicLinkToken(context.Background(), request)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, logic.ScopeAccess, tokenResponseHeaders.Response.Scope)
+	assert.Equal(t, tokenLength, len(tokenResponseHeaders.Response.Token))
+	assert.NotEmpty(t, tokenResponseHeaders.SetCookie.Value)
+
+	user, err := h.AdminGetUser(context.Background(), api.AdminGetUserParams{ID: testMagicLinkUserID})
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.True(t, user.Activated)
+
+	response, err := h.ExchangeMagicLinkToken(context.Background(), request)
+	if !errors.Is(err, logic.ErrInvalidToken) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestExchangeMagicLinkToken_Disabled(t *testing.T) {
+	request := &api.TokenRequest{Token: testDisabledMagicLinkToken}
+
+	response, err := newTestHandler(t).ExchangeMagicLinkToken(context.Background(), request)
+	if !errors.Is(err, logic.ErrUserDisabled) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestExchangeMagicLinkToken_Locked(t *testing.T) {
+	request := &api.TokenRequest{Token: "LOCKEDMAGICLINKLOCKEDMAGIC"}
+
+	response, err := newTestHandler(t).ExchangeMagicLinkToken(context.Background(), request)
+	if !errors.Is(err, logic.ErrAccountLocked) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestExchangeMagicLinkToken_Concurrent(t *testing.T) {
+	h := newTestHandler(t)
+
+	magicLinkToken, err := logic.NewMagicLinkToken(context.Background(), h.Queries, testMagicLinkUserEmail)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	// Both exchanges race for the same token, only one of them may sign in.
+	errs := make(chan error, 2)
+
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := h.ExchangeMagicLinkToken(context.Background(), &api.TokenRequest{Token: magicLinkToken.Token})
+			errs <- err
+		}()
+	}
+
+	first, second := <-errs, <-errs
+	if first == nil {
+		first, second = second, first
+	}
+
+	assert.ErrorIs(t, first, logic.ErrInvalidToken)
+	assert.NoError(t, second)
+}
+
 func TestNewPasswordResetToken_Success(t *testing.T) {
 	request := &api.UserEmailRequest{
 		Email: "activated@test.com",
@@ -62,11 +255,16 @@ func TestNewPasswordResetToken_Success(t *testing.T) {
 		t.Fatalf(unexpectedError, err)
 	}
 
-	assert.Equal(t, logic.ScopePasswordReset, response.Scope)
-	assert.Equal(t, tokenLength, len(response.Token))
-	assert.IsType(t, time.Time{}, response.Expiry)
+	passwordResetToken, ok := response.(*api.TokenResponse)
+	if !ok {
+		t.Fatal(unexpectedResponse)
+	}
 
-	if matches := regexp.MustCompile(`^([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{2}==)?$`).MatchString(response.Token); matches {
+	assert.Equal(t, logic.ScopePasswordReset, passwordResetToken.Scope)
+	assert.Equal(t, tokenLength, len(passwordResetToken.Token))
+	assert.IsType(t, time.Time{}, passwordResetToken.Expiry)
+
+	if matches := regexp.MustCompile(`^([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{2}==)?$`).MatchString(passwordResetToken.Token); matches {
 		t.Fatal(invalidToken)
 	}
 }
@@ -86,6 +284,128 @@ func TestNewPasswordResetToken_NotFound(t *testing.T) {
 	}
 }
 
+func TestNewPasswordResetToken_Disabled(t *testing.T) {
+	request := &api.UserEmailRequest{
+		Email: testDisabledUserEmail,
+	}
+
+	response, err := newTestHandler(t).NewPasswordResetToken(context.Background(), request)
+	if !errors.Is(err, logic.ErrUserDisabled) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestNewPasswordResetToken_PrivacyMode(t *testing.T) {
+	h := newTestHandler(t)
+	withPrivacyMode(t)
+
+	var responses []api.NewPasswordResetTokenRes
+
+	for _, email := range []string{"notfound@test.com", "unactivated@test.com", "activated@test.com"} {
+		response, err := h.NewPasswordResetToken(context.Background(), &api.UserEmailRequest{Email: email})
+		if err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		assert.IsType(t, &api.AcceptanceResponse{}, response)
+
+		responses = append(responses, response)
+	}
+
+	assert.Equal(t, responses[0], responses[1])
+	assert.Equal(t, responses[0], responses[2])
+}
+
+// Every privacy mode token request only enqueues a job, so whether the email has an account can't be told from the
+// writes, or the time, it takes to answer.
+func TestTokenRequests_PrivacyModeWrites(t *testing.T) {
+	h := newTestHandler(t)
+	dbpool := newTestPool(t)
+	withPrivacyMode(t)
+
+	requests := map[string]func(email string) error{
+		"activation": func(email string) error {
+			_, err := h.NewActivationToken(context.Background(), &api.UserEmailRequest{Email: email})
+			return err
+		},
+		"password reset": func(email string) error {
+			_, err := h.NewPasswordResetToken(context.Background(), &api.UserEmailRequest{Email: email})
+			return err
+		},
+		"magic link": func(email string) error {
+			_, err := h.NewMagicLinkToken(context.Background(), &api.UserEmailRequest{Email: email})
+			return err
+		},
+	}
+
+	// Jobs are counted by kind, the jobs package tests share the table.
+	counts := func() [3]int {
+		return [3]int{
+			countRows(t, dbpool, "SELECT count(*) FROM jobs WHERE kind = $1", logic.TokenRequest{}.Kind()),
+			countRows(t, dbpool, "SELECT count(*) FROM tokens"),
+			countRows(t, dbpool, "SELECT count(*) FROM audit_events"),
+		}
+	}
+
+	for name, request := range requests {
+		for _, email := range []string{"notfound@test.com", "activated@test.com"} {
+			before := counts()
+
+			if err := request(email); err != nil {
+				t.Fatalf(unexpectedError, err)
+			}
+
+			// One job, no token and no audit event, whether or not the email has an account.
+			assert.Equal(t, [3]int{before[0] + 1, before[1], before[2]}, counts(), name+" "+email)
+		}
+	}
+}
+
+func TestHandleTokenRequest(t *testing.T) {
+	newTestHandler(t)
+	dbpool := newTestPool(t)
+
+	tests := []struct {
+		email  string
+		tokens int
+	}{
+		{email: "notfound@test.com", tokens: 0},
+		{email: "activated@test.com", tokens: 1},
+	}
+
+	for _, tt := range tests {
+		tx, err := dbpool.Begin(context.Background())
+		if err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		var before, after int
+
+		countTokens := "SELECT count(*) FROM tokens WHERE scope = $1"
+
+		if err = tx.QueryRow(context.Background(), countTokens, logic.ScopeMagicLink).Scan(&before); err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		req := logic.TokenRequest{Scope: logic.ScopeMagicLink, Email: tt.email}
+		if err = logic.HandleTokenRequest(context.Background(), tx, nil, req); err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		if err = tx.QueryRow(context.Background(), countTokens, logic.ScopeMagicLink).Scan(&after); err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		assert.Equal(t, tt.tokens, after-before, tt.email)
+
+		_ = tx.Rollback(context.Background())
+	}
+}
+
 func TestNewRefreshToken_Success(t *testing.T) {
 	request := &api.UserLoginRequest{
 		Email:    "activated@test.com",
@@ -127,6 +447,50 @@ func TestNewRefreshToken_NotFound(t *testing.T) {
 	}
 }
 
+func TestNewRefreshToken_Disabled(t *testing.T) {
+	request := &api.UserLoginRequest{
+		Email:    testDisabledUserEmail,
+		Password: "testtest",
+	}
+
+	response, err := newTestHandler(t).NewRefreshToken(context.Background(), request)
+	if !errors.Is(err, logic.ErrUserDisabled) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestNewRefreshToken_Locked(t *testing.T) {
+	request := &api.UserLoginRequest{
+		Email:    "locked@test.com",
+		Password: "wrongpassword",
+	}
+
+	var err error
+
+	for i := 0; i < 5; i++ {
+		_, err = newTestHandler(t).NewRefreshToken(context.Background(), request)
+	}
+
+	if !errors.Is(err, logic.ErrAccountLocked) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	request.Password = "testtest"
+
+	response, err := newTestHandler(t).NewRefreshToken(context.Background(), request)
+	if !errors.Is(err, logic.ErrAccountLocked) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
 func TestNewAccessToken_Success(t *testing.T) {
 	ctx := utils.ContextSetCookieValue(context.Background(), "AYNSWD44H2JKZAOHE3HF2BUK34")
 
@@ -161,3 +525,16 @@ func TestNewAccessToken_NotFound(t *testing.T) {
 		t.Error(unexpectedResponse)
 	}
 }
+
+func TestNewAccessToken_RevokedSession(t *testing.T) {
+	ctx := utils.ContextSetCookieValue(context.Background(), "REVOKEDREVOKEDREVOKEDREVOK")
+
+	response, err := newTestHandler(t).NewAccessToken(ctx)
+	if !errors.Is(err, logic.ErrInvalidToken) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 
 	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
 	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
 	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/utils"
 )
 
 func (s *Handler) ActivateUser(ctx context.Context, req *api.TokenRequest) (*api.UserResponse, error) {
@@ -17,16 +20,19 @@ func (s *Handler) ActivateUser(ctx context.Context, req *api.TokenRequest) (*api
 	return user, nil
 }
 
-func (s *Handler) NewUser(ctx context.Context, req *api.UserRequest) (*api.UserResponse, error) {
-	user, refreshToken, err := logic.NewUser(ctx, s.Queries, req.Name, req.Email, req.Password)
+func (s *Handler) NewUser(ctx context.Context, req *api.UserRequest) (api.NewUserRes, error) {
+	params := logic.NewUserParams{Name: req.Name, Email: req.Email, Password: req.Password, Locale: req.Locale.Value}
+
+	var user api.NewUserRes
+
+	err := pgx.BeginFunc(ctx, s.Pool, func(tx pgx.Tx) (err error) {
+		user, err = logic.NewUser(ctx, data.New(tx), params)
+		return err //nolint:wrapcheck
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed new user")
 	}
 
-	if err = s.Mailer.Send(user.Email, "token_activation.tmpl", map[string]any{"activationToken": refreshToken.Token}); err != nil {
-		return nil, errors.Wrap(err, "failed send new user activation token email")
-	}
-
 	return user, nil
 }
 
@@ -38,3 +44,23 @@ func (s *Handler) UpdateUserPassword(ctx context.Context, req *api.UpdateUserPas
 
 	return acceptanceResponse, nil
 }
+
+func (s *Handler) UnlockUser(ctx context.Context, req *api.TokenRequest) (*api.AcceptanceResponse, error) {
+	acceptanceResponse, err := logic.UnlockUser(ctx, s.Queries, req.Token)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed unlock user")
+	}
+
+	return acceptanceResponse, nil
+}
+
+func (s *Handler) GetCurrentUser(ctx context.Context) (*api.UserProfileResponse, error) {
+	user := utils.ContextGetUser(ctx)
+
+	profile, err := logic.GetCurrentUser(ctx, s.Queries, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get current user")
+	}
+
+	return profile, nil
+}
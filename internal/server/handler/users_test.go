@@ -6,10 +6,16 @@ import (
 
 	"github.com/go-faster/errors"
 	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
 	"github.com/seanflannery10/core/internal/server/logic"
+	"github.com/seanflannery10/core/internal/shared/locale"
+	"github.com/seanflannery10/core/internal/shared/password"
+	"github.com/seanflannery10/core/internal/shared/utils"
 	"github.com/stretchr/testify/assert"
 )
 
+const testUserIDBounced = 8
+
 func TestActivateUser_Success(t *testing.T) {
 	request := &api.TokenRequest{
 		Token: "HJUKX2HGBVUJJ2R2RVGFB4RZ3I",
@@ -39,6 +45,21 @@ func TestActivateUser_NotFound(t *testing.T) {
 	}
 }
 
+func TestActivateUser_Disabled(t *testing.T) {
+	request := &api.TokenRequest{
+		Token: testDisabledActivationToken,
+	}
+
+	response, err := newTestHandler(t).ActivateUser(context.Background(), request)
+	if !errors.Is(err, logic.ErrUserDisabled) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
 func TestNewUser_Success(t *testing.T) {
 	request := &api.UserRequest{
 		Name:     "newtest",
@@ -51,8 +72,42 @@ func TestNewUser_Success(t *testing.T) {
 		t.Fatalf(unexpectedError, err)
 	}
 
-	assert.Equal(t, "newtest", response.Name)
-	assert.Equal(t, "newtest@test.com", response.Email)
+	user, ok := response.(*api.UserResponse)
+	if !ok {
+		t.Fatal(unexpectedResponse)
+	}
+
+	assert.Equal(t, "newtest", user.Name)
+	assert.Equal(t, "newtest@test.com", user.Email)
+}
+
+func TestNewUser_PasswordPolicy(t *testing.T) {
+	h := newTestHandler(t)
+
+	cfg := testConfig
+	cfg.PasswordPolicy = password.Policy{MinScore: 2, BlockPersonalInfo: true}
+
+	logic.Configure(cfg)
+	t.Cleanup(func() { logic.Configure(testConfig) })
+
+	request := &api.UserRequest{
+		Name:     "policytest",
+		Email:    "policytest@test.com",
+		Password: "policytest-2023",
+	}
+
+	response, err := h.NewUser(context.Background(), request)
+
+	var policyErr *password.PolicyError
+	if !errors.As(err, &policyErr) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, password.RulePersonalInfo, policyErr.Rule)
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
 }
 
 func TestNewUser_UnprocessableEntity(t *testing.T) {
@@ -72,6 +127,109 @@ func TestNewUser_UnprocessableEntity(t *testing.T) {
 	}
 }
 
+func TestNewUser_PrivacyMode(t *testing.T) {
+	h := newTestHandler(t)
+	withPrivacyMode(t)
+
+	// Registering an email that has an account looks like registering a new one, the account is emailed instead.
+	existing, err := h.NewUser(context.Background(), &api.UserRequest{Name: "testexists", Email: "activated@test.com", Password: "testtest"})
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	created, err := h.NewUser(context.Background(), &api.UserRequest{Name: "privacytest", Email: "privacytest@test.com", Password: "testtest"})
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.IsType(t, &api.AcceptanceResponse{}, existing)
+	assert.Equal(t, existing, created)
+}
+
+func TestNewUser_PrivacyModeWrites(t *testing.T) {
+	h := newTestHandler(t)
+	dbpool := newTestPool(t)
+	withPrivacyMode(t)
+
+	// Jobs are counted by kind, the jobs package tests share the table.
+	counts := func() [3]int {
+		return [3]int{
+			countRows(t, dbpool, "SELECT count(*) FROM jobs WHERE kind = $1", logic.Registration{}.Kind()),
+			countRows(t, dbpool, "SELECT count(*) FROM users"),
+			countRows(t, dbpool, "SELECT count(*) FROM tokens"),
+		}
+	}
+
+	for _, email := range []string{"privacywrites@test.com", "activated@test.com"} {
+		before := counts()
+
+		_, err := h.NewUser(context.Background(), &api.UserRequest{Name: "privacywrites", Email: email, Password: "testtest"})
+		if err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		// One job, no user and no token, whether or not the email has an account.
+		assert.Equal(t, [3]int{before[0] + 1, before[1], before[2]}, counts(), email)
+	}
+}
+
+func TestHandleRegistration(t *testing.T) {
+	newTestHandler(t)
+	dbpool := newTestPool(t)
+
+	tests := []struct {
+		email string
+		users int
+	}{
+		{email: "registrationjob@test.com", users: 1},
+		{email: "activated@test.com", users: 0},
+	}
+
+	for _, tt := range tests {
+		tx, err := dbpool.Begin(context.Background())
+		if err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		var before, after int
+
+		if err = tx.QueryRow(context.Background(), "SELECT count(*) FROM users").Scan(&before); err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		registration := logic.Registration{Name: "registrationjob", Email: tt.email, PasswordHash: []byte("hash"), Locale: "en"}
+		if err = logic.HandleRegistration(context.Background(), tx, nil, registration); err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		if err = tx.QueryRow(context.Background(), "SELECT count(*) FROM users").Scan(&after); err != nil {
+			t.Fatalf(unexpectedError, err)
+		}
+
+		assert.Equal(t, tt.users, after-before, tt.email)
+
+		_ = tx.Rollback(context.Background())
+	}
+}
+
+func TestNewUser_InvalidLocale(t *testing.T) {
+	request := &api.UserRequest{
+		Name:     "newtest",
+		Email:    "newlocale@test.com",
+		Password: "testtest",
+		Locale:   api.NewOptString("not a locale"),
+	}
+
+	response, err := newTestHandler(t).NewUser(context.Background(), request)
+	if !errors.Is(err, locale.ErrInvalidLocale) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
 func TestUpdateUserPassword_Success(t *testing.T) {
 	request := &api.UpdateUserPasswordRequest{
 		Password: "newtestpass",
@@ -101,3 +259,73 @@ func TestUpdateUserPassword_NotFound(t *testing.T) {
 		t.Error(unexpectedResponse)
 	}
 }
+
+func TestUpdateUserPassword_Disabled(t *testing.T) {
+	request := &api.UpdateUserPasswordRequest{
+		Password: "newtestpass",
+		Token:    testDisabledPasswordReset,
+	}
+
+	response, err := newTestHandler(t).UpdateUserPassword(context.Background(), request)
+	if !errors.Is(err, logic.ErrUserDisabled) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestUnlockUser_Success(t *testing.T) {
+	request := &api.TokenRequest{
+		Token: "UNLOCKUNLOCKUNLOCKUNLOCK22",
+	}
+
+	expected := &api.AcceptanceResponse{Message: "account unlocked"}
+
+	response, err := newTestHandler(t).UnlockUser(context.Background(), request)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, expected, response)
+}
+
+func TestUnlockUser_NotFound(t *testing.T) {
+	request := &api.TokenRequest{
+		Token: "NOTFOUND",
+	}
+
+	response, err := newTestHandler(t).UnlockUser(context.Background(), request)
+	if !errors.Is(err, logic.ErrInvalidToken) {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	if response != nil {
+		t.Error(unexpectedResponse)
+	}
+}
+
+func TestGetCurrentUser_Success(t *testing.T) {
+	response, err := newTestHandler(t).GetCurrentUser(ctxWithTestUser(t))
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, int64(testUserID), response.ID)
+	assert.Equal(t, "messages@test.com", response.Email)
+	assert.False(t, response.EmailSuppression.Set)
+}
+
+func TestGetCurrentUser_Suppressed(t *testing.T) {
+	ctx := utils.ContextSetUser(context.Background(), &data.User{ID: testUserIDBounced, Activated: true})
+
+	response, err := newTestHandler(t).GetCurrentUser(ctx)
+	if err != nil {
+		t.Fatalf(unexpectedError, err)
+	}
+
+	assert.Equal(t, "bounced@test.com", response.Email)
+	assert.True(t, response.EmailSuppression.Set)
+	assert.Equal(t, api.EmailSuppressionReasonBounce, response.EmailSuppression.Value.Reason)
+}
@@ -0,0 +1,304 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/notify"
+	"github.com/seanflannery10/core/internal/shared/pagination"
+)
+
+func AdminGetUsers(ctx context.Context, q *data.Queries, search string, page, pageSize int32) (*api.AdminUsersResponse, error) {
+	p := pagination.New(page, pageSize)
+
+	usersFromDB, err := q.GetUsers(ctx, data.GetUsersParams{Search: search, Offset: p.Offset(), Limit: p.Limit()})
+	if err != nil {
+		return nil, fmt.Errorf("failed get users: %w", err)
+	}
+
+	count, err := q.GetUserCount(ctx, search)
+	if err != nil {
+		return nil, fmt.Errorf("failed get user count: %w", err)
+	}
+
+	metadata, err := p.CalculateMetadata(count)
+	if err != nil {
+		return nil, pagination.ErrPageValueToHigh
+	}
+
+	users := make([]api.AdminUserResponse, len(usersFromDB))
+	for i, v := range usersFromDB {
+		users[i] = newAdminUserResponse(v)
+	}
+
+	adminUsersResponse := &api.AdminUsersResponse{Users: users, Metadata: metadata}
+
+	return adminUsersResponse, nil
+}
+
+func AdminGetUser(ctx context.Context, q *data.Queries, id int64) (*api.AdminUserResponse, error) {
+	user, err := getUserFromID(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+
+	adminUserResponse := newAdminUserResponse(user)
+
+	return &adminUserResponse, nil
+}
+
+// AdminDeactivateUser disables the user and revokes every token they hold. A disabled user can neither sign in nor
+// activate their account again, only AdminReactivateUser lifts it.
+func AdminDeactivateUser(ctx context.Context, q *data.Queries, id int64) (*api.AdminUserResponse, error) {
+	user, err := setUserDisabled(ctx, q, id, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed deactivate user: %w", err)
+	}
+
+	if err = q.DeleteAllTokens(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed delete all tokens: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeAdminUserDeactivated, user.ID, nil)
+
+	adminUserResponse := newAdminUserResponse(user)
+
+	return &adminUserResponse, nil
+}
+
+// AdminReactivateUser enables a disabled user, it also activates a user that never activated their account.
+func AdminReactivateUser(ctx context.Context, q *data.Queries, id int64) (*api.AdminUserResponse, error) {
+	user, err := setUserDisabled(ctx, q, id, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed reactivate user: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeAdminUserReactivated, user.ID, nil)
+
+	adminUserResponse := newAdminUserResponse(user)
+
+	return &adminUserResponse, nil
+}
+
+// AdminNewUser creates a user on behalf of an operator. An activated user gets the welcome email instead of an
+// activation token.
+func AdminNewUser(ctx context.Context, q *data.Queries, params NewUserParams, activate bool) (*api.AdminUserResponse, error) {
+	newUser, err := prepareUser(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !activate {
+		if _, err = registerUser(ctx, q, newUser); err != nil {
+			return nil, err
+		}
+
+		return AdminGetUserFromEmail(ctx, q, params.Email)
+	}
+
+	_, activationToken, err := createUser(ctx, q, newUser)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = ActivateUser(ctx, q, activationToken.Token); err != nil {
+		return nil, err
+	}
+
+	return AdminGetUserFromEmail(ctx, q, params.Email)
+}
+
+func AdminForcePasswordReset(ctx context.Context, q *data.Queries, id int64) (*api.AdminUserResponse, error) {
+	user, err := getUserFromID(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.DeleteAllTokens(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed delete all tokens: %w", err)
+	}
+
+	passwordResetToken, err := newToken(ctx, q, config.TokenTTL.PasswordReset, ScopePasswordReset, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed create password reset token: %w", err)
+	}
+
+	templateData := map[string]any{"passwordResetToken": passwordResetToken.Token, "expiresIn": config.TokenTTL.PasswordReset}
+
+	if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.PasswordResetRequested, User: user, Data: templateData}); err != nil {
+		return nil, fmt.Errorf("failed notify password reset requested: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeAdminPasswordResetForced, user.ID, nil)
+
+	adminUserResponse := newAdminUserResponse(user)
+
+	return &adminUserResponse, nil
+}
+
+// AdminResendActivationToken emails a new activation token to a user that is not activated yet, unlike
+// NewActivationToken it reports an activated user whatever the privacy mode.
+func AdminResendActivationToken(ctx context.Context, q *data.Queries, id int64) (*api.AdminUserResponse, error) {
+	user, err := getUserFromID(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Disabled {
+		return nil, ErrUserDisabled
+	}
+
+	if user.Activated {
+		return nil, ErrUserAlreadyActivated
+	}
+
+	if _, err = sendActivationToken(ctx, q, user); err != nil {
+		return nil, err
+	}
+
+	adminUserResponse := newAdminUserResponse(user)
+
+	return &adminUserResponse, nil
+}
+
+func AdminGetUserSessions(ctx context.Context, q *data.Queries, id int64) (*api.SessionsResponse, error) {
+	user, err := getUserFromID(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionsResponse, err := GetUserSessions(ctx, q, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionsResponse, nil
+}
+
+func AdminGetUserFromEmail(ctx context.Context, q *data.Queries, email string) (*api.AdminUserResponse, error) {
+	user, err := q.GetUserFromEmail(ctx, email)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrEmailNotFound
+		default:
+			return nil, fmt.Errorf("failed get user from email: %w", err)
+		}
+	}
+
+	adminUserResponse := newAdminUserResponse(user)
+
+	return &adminUserResponse, nil
+}
+
+// UserExport is everything stored about a user, as handed over for a data access request.
+type UserExport struct {
+	User        api.AdminUserResponse    `json:"user"`
+	Messages    []api.MessageResponse    `json:"messages"`
+	Sessions    []api.SessionResponse    `json:"sessions"`
+	APIKeys     []api.APIKeyResponse     `json:"api_keys"`
+	AuditEvents []api.AuditEventResponse `json:"audit_events"`
+}
+
+func AdminExportUser(ctx context.Context, q *data.Queries, id int64) (*UserExport, error) {
+	user, err := getUserFromID(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+
+	messagesFromDB, err := q.ExportUserMessages(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed export user messages: %w", err)
+	}
+
+	sessions, err := GetUserSessions(ctx, q, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := GetUserAPIKeys(ctx, q, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsFromDB, err := q.ExportUserAuditEvents(ctx, pgtype.Int8{Int64: user.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed export user audit events: %w", err)
+	}
+
+	export := &UserExport{
+		User:        newAdminUserResponse(user),
+		Messages:    make([]api.MessageResponse, len(messagesFromDB)),
+		Sessions:    sessions.Sessions,
+		APIKeys:     apiKeys.APIKeys,
+		AuditEvents: make([]api.AuditEventResponse, len(eventsFromDB)),
+	}
+
+	for i, v := range messagesFromDB {
+		export.Messages[i] = api.MessageResponse{ID: v.ID, Message: v.Message, Version: v.Version}
+	}
+
+	for i, v := range eventsFromDB {
+		if export.AuditEvents[i], err = newAuditEventResponse(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return export, nil
+}
+
+func getUserFromID(ctx context.Context, q *data.Queries, id int64) (*data.User, error) {
+	user, err := q.GetUserFromID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrUserNotFound
+		default:
+			return nil, fmt.Errorf("failed get user from id: %w", err)
+		}
+	}
+
+	return user, nil
+}
+
+// setUserDisabled disables the user, or enables and activates them.
+func setUserDisabled(ctx context.Context, q *data.Queries, id int64, disabled bool) (*data.User, error) {
+	user, err := getUserFromID(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := data.UpdateUserParams{UpdateDisabled: true, Disabled: disabled, ID: user.ID, Version: user.Version}
+	if !disabled {
+		params.UpdateActivated, params.Activated = true, true
+	}
+
+	user, err = q.UpdateUser(ctx, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrEditConflict
+		default:
+			return nil, fmt.Errorf("failed update user: %w", err)
+		}
+	}
+
+	return user, nil
+}
+
+func newAdminUserResponse(user *data.User) api.AdminUserResponse {
+	return api.AdminUserResponse{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Activated: user.Activated,
+		Disabled:  user.Disabled,
+		CreatedAt: user.CreatedAt,
+		Version:   user.Version,
+	}
+}
@@ -0,0 +1,107 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
+)
+
+const APIKeyPrefix = "core_"
+
+func GetUserAPIKeys(ctx context.Context, q *data.Queries, userID int64) (*api.APIKeysResponse, error) {
+	keysFromDB, err := q.GetUserAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed get user api keys: %w", err)
+	}
+
+	keys := make([]api.APIKeyResponse, len(keysFromDB))
+	for i, v := range keysFromDB {
+		keys[i] = newAPIKeyResponse(v)
+	}
+
+	apiKeysResponse := &api.APIKeysResponse{APIKeys: keys}
+
+	return apiKeysResponse, nil
+}
+
+func NewAPIKey(ctx context.Context, q *data.Queries, req *api.APIKeyRequest, userID int64) (*api.APIKeyResponse, error) {
+	if req.Expiry.Set && req.Expiry.Value.Before(time.Now()) {
+		return nil, ErrInvalidExpiry
+	}
+
+	const lengthRandom = 32
+	randomBytes := make([]byte, lengthRandom)
+
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, fmt.Errorf("failed read rand: %w", err)
+	}
+
+	plaintext := APIKeyPrefix + base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(randomBytes)
+	hash := sha256.Sum256([]byte(plaintext))
+
+	permissions := make([]string, len(req.Permissions))
+	for i, v := range req.Permissions {
+		permissions[i] = string(v)
+	}
+
+	key, err := q.CreateAPIKey(ctx, data.CreateAPIKeyParams{
+		Name:        req.Name,
+		Hash:        hash[:],
+		Permissions: permissions,
+		Expiry:      pgtype.Timestamp{Time: req.Expiry.Value, Valid: req.Expiry.Set},
+		UserID:      userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed create api key: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeAPIKeyCreated, userID, map[string]any{"api_key_id": key.ID, "name": key.Name})
+
+	apiKeyResponse := newAPIKeyResponse(key)
+	apiKeyResponse.Key = api.OptString{Value: plaintext, Set: true}
+
+	return &apiKeyResponse, nil
+}
+
+func DeleteAPIKey(ctx context.Context, q *data.Queries, kid, uid int64) (*api.AcceptanceResponse, error) {
+	_, err := q.DeleteAPIKey(ctx, data.DeleteAPIKeyParams{ID: kid, UserID: uid})
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrAPIKeyNotFound
+		default:
+			return nil, fmt.Errorf("failed delete api key: %w", err)
+		}
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeAPIKeyRevoked, uid, map[string]any{"api_key_id": kid})
+
+	acceptanceResponse := &api.AcceptanceResponse{Message: "api key revoked"}
+
+	return acceptanceResponse, nil
+}
+
+func newAPIKeyResponse(key *data.ApiKey) api.APIKeyResponse {
+	permissions := make([]api.Permission, len(key.Permissions))
+	for i, v := range key.Permissions {
+		permissions[i] = api.Permission(v)
+	}
+
+	return api.APIKeyResponse{
+		ID:          key.ID,
+		Name:        key.Name,
+		Permissions: permissions,
+		Expiry:      api.OptDateTime{Value: key.Expiry.Time, Set: key.Expiry.Valid},
+		LastUsedAt:  api.OptDateTime{Value: key.LastUsedAt.Time, Set: key.LastUsedAt.Valid},
+		CreatedAt:   key.CreatedAt,
+	}
+}
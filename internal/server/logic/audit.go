@@ -0,0 +1,131 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-faster/jx"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/shared/pagination"
+	"github.com/seanflannery10/core/internal/shared/utils"
+	"golang.org/x/exp/slog"
+)
+
+type AuditEventFilter struct {
+	UserID    api.OptInt64
+	EventType api.OptAuditEventType
+	Since     api.OptDateTime
+	Until     api.OptDateTime
+}
+
+func GetUserAuditEvents(ctx context.Context, q *data.Queries, userID int64, page, pageSize int32) (*api.AuditEventsResponse, error) {
+	filter := data.GetAuditEventCountParams{UserID: pgtype.Int8{Int64: userID, Valid: true}}
+
+	return getAuditEvents(ctx, q, filter, page, pageSize)
+}
+
+func AdminGetAuditEvents(ctx context.Context, q *data.Queries, f AuditEventFilter, page, pageSize int32) (*api.AuditEventsResponse, error) {
+	filter := data.GetAuditEventCountParams{
+		UserID:    pgtype.Int8{Int64: f.UserID.Value, Valid: f.UserID.Set},
+		EventType: pgtype.Text{String: string(f.EventType.Value), Valid: f.EventType.Set},
+		Since:     pgtype.Timestamp{Time: f.Since.Value, Valid: f.Since.Set},
+		Until:     pgtype.Timestamp{Time: f.Until.Value, Valid: f.Until.Set},
+	}
+
+	return getAuditEvents(ctx, q, filter, page, pageSize)
+}
+
+func getAuditEvents(ctx context.Context, q *data.Queries, f data.GetAuditEventCountParams, page, pageSize int32) (*api.AuditEventsResponse, error) {
+	p := pagination.New(page, pageSize)
+
+	eventsFromDB, err := q.GetAuditEvents(ctx, data.GetAuditEventsParams{
+		UserID:    f.UserID,
+		EventType: f.EventType,
+		Since:     f.Since,
+		Until:     f.Until,
+		Offset:    p.Offset(),
+		Limit:     p.Limit(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed get audit events: %w", err)
+	}
+
+	count, err := q.GetAuditEventCount(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed get audit event count: %w", err)
+	}
+
+	metadata, err := p.CalculateMetadata(count)
+	if err != nil {
+		return nil, pagination.ErrPageValueToHigh
+	}
+
+	events := make([]api.AuditEventResponse, len(eventsFromDB))
+	for i, v := range eventsFromDB {
+		events[i], err = newAuditEventResponse(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	auditEventsResponse := &api.AuditEventsResponse{Events: events, Metadata: metadata}
+
+	return auditEventsResponse, nil
+}
+
+// recordAuditEvent appends an event about userID to the audit log, a userID of zero records an event that is not
+// tied to a known user. The actor is the authenticated user of the request when there is one and the user the event
+// is about otherwise. Failing to record an event is logged and doesn't fail an operation run on autocommit queries.
+// Inside a transaction Postgres aborts the whole transaction on a failed insert, so the operation fails with it.
+func recordAuditEvent(ctx context.Context, q *data.Queries, eventType api.AuditEventType, userID int64, details map[string]any) {
+	if details == nil {
+		details = map[string]any{}
+	}
+
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		slog.ErrorCtx(ctx, "unable to encode audit event details", "error", err, "event_type", eventType)
+		return
+	}
+
+	actorID := userID
+	if actor, ok := utils.ContextLookupUser(ctx); ok {
+		actorID = actor.ID
+	}
+
+	client := utils.ContextGetClient(ctx)
+
+	err = q.CreateAuditEvent(ctx, data.CreateAuditEventParams{
+		EventType: string(eventType),
+		UserID:    pgtype.Int8{Int64: userID, Valid: userID != 0},
+		ActorID:   pgtype.Int8{Int64: actorID, Valid: actorID != 0},
+		IpAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+		Details:   detailsJSON,
+	})
+	if err != nil {
+		slog.ErrorCtx(ctx, "unable to record audit event", "error", err, "event_type", eventType)
+	}
+}
+
+func newAuditEventResponse(event *data.AuditEvent) (api.AuditEventResponse, error) {
+	details := api.AuditEventResponseDetails{}
+
+	if err := details.Decode(jx.DecodeBytes(event.Details)); err != nil {
+		return api.AuditEventResponse{}, fmt.Errorf("failed decode audit event details: %w", err)
+	}
+
+	return api.AuditEventResponse{
+		ID:        event.ID,
+		CreatedAt: event.CreatedAt,
+		EventType: api.AuditEventType(event.EventType),
+		UserID:    api.OptInt64{Value: event.UserID.Int64, Set: event.UserID.Valid},
+		ActorID:   api.OptInt64{Value: event.ActorID.Int64, Set: event.ActorID.Valid},
+		IPAddress: event.IpAddress,
+		UserAgent: event.UserAgent,
+		Details:   details,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"time"
+
+	"github.com/seanflannery10/core/internal/shared/keyring"
+	"github.com/seanflannery10/core/internal/shared/password"
+)
+
+type (
+	// Config holds the settings used by the logic package, it is set once at startup by Configure. PrivacyMode makes
+	// registration and token requests answer the same whether or not the email has an account, so they cannot be
+	// used to find out who has one. Keyring seals the template data of queued emails.
+	Config struct {
+		NotifyNewSignIn bool
+		PrivacyMode     bool
+		Keyring         *keyring.Keyring
+		PasswordHasher  password.Hasher
+		PasswordPolicy  password.Policy
+		TokenTTL        TokenTTL
+		Lockout         Lockout
+	}
+	TokenTTL struct {
+		Access        time.Duration `env:"ACCESS_TOKEN_TTL,default=1h"`
+		Activation    time.Duration `env:"ACTIVATION_TOKEN_TTL,default=72h"`
+		MagicLink     time.Duration `env:"MAGIC_LINK_TOKEN_TTL,default=15m"`
+		PasswordReset time.Duration `env:"PASSWORD_RESET_TOKEN_TTL,default=45m"`
+		Refresh       time.Duration `env:"REFRESH_TOKEN_TTL,default=168h"`
+		Unlock        time.Duration `env:"UNLOCK_TOKEN_TTL,default=24h"`
+	}
+	// Lockout locks an account after Threshold consecutive failed logins, for Base doubled with every previous
+	// lockout and capped at Max.
+	Lockout struct {
+		Threshold int32         `env:"LOCKOUT_THRESHOLD,default=5"`
+		Base      time.Duration `env:"LOCKOUT_BASE,default=1m"`
+		Max       time.Duration `env:"LOCKOUT_MAX,default=24h"`
+	}
+)
+
+var (
+	DefaultTokenTTL = TokenTTL{
+		Access:        time.Hour,
+		Activation:    3 * 24 * time.Hour,
+		MagicLink:     15 * time.Minute,
+		PasswordReset: 45 * time.Minute,
+		Refresh:       7 * 24 * time.Hour,
+		Unlock:        24 * time.Hour,
+	}
+	DefaultLockout = Lockout{Threshold: 5, Base: time.Minute, Max: 24 * time.Hour}
+)
+
+var config = Config{PasswordHasher: password.DefaultArgon2id, TokenTTL: DefaultTokenTTL, Lockout: DefaultLockout}
+
+// Configure replaces the package settings, unset hashers, token TTLs and lockouts fall back to their defaults.
+func Configure(cfg Config) {
+	if cfg.PasswordHasher == nil {
+		cfg.PasswordHasher = password.DefaultArgon2id
+	}
+
+	if cfg.TokenTTL == (TokenTTL{}) {
+		cfg.TokenTTL = DefaultTokenTTL
+	}
+
+	if cfg.Lockout == (Lockout{}) {
+		cfg.Lockout = DefaultLockout
+	}
+
+	config = cfg
+}
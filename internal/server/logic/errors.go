@@ -3,15 +3,25 @@ package logic
 import "github.com/go-faster/errors"
 
 var (
+	ErrAccountLocked        = errors.New("account temporarily locked due to too many failed login attempts")
 	ErrActivationRequired   = errors.New("user account must be activated")
+	ErrAPIKeyNotFound       = errors.New("no matching api key found")
 	ErrEditConflict         = errors.New("unable to update the record due to an edit conflict")
 	ErrEmailNotFound        = errors.New("no matching email address found")
 	ErrInvalidAccessToken   = errors.New("invalid access token")
+	ErrInvalidAPIKey        = errors.New("invalid api key")
 	ErrInvalidCredentials   = errors.New("invalid authentication credentials")
+	ErrInvalidExpiry        = errors.New("expiry must be in the future")
 	ErrInvalidToken         = errors.New("invalid or missing token")
 	ErrMessageNotFound      = errors.New("no matching message found")
+	ErrPermissionDenied     = errors.New("permission denied to perform this operation")
 	ErrReusedRefreshToken   = errors.New("reused refresh token")
+	ErrRoleNotFound         = errors.New("no matching role found")
 	ErrServerError          = errors.New("the server encountered a problem and could not process your request")
+	ErrSessionNotFound      = errors.New("no matching session found")
+	ErrUnknownScope         = errors.New("unknown token scope")
 	ErrUserAlreadyActivated = errors.New("user has already been activated")
+	ErrUserDisabled         = errors.New("user account has been disabled")
 	ErrUserExists           = errors.New("a user with this email address already exists")
+	ErrUserNotFound         = errors.New("no matching user found")
 )
@@ -9,21 +9,31 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/seanflannery10/core/internal/generated/api"
 	"github.com/seanflannery10/core/internal/generated/data"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/seanflannery10/core/internal/server/notify"
+	"github.com/seanflannery10/core/internal/shared/password"
+	"golang.org/x/exp/slog"
 )
 
 const (
-	PasswordCost       = 13
 	ScopeAccess        = "access"
 	ScopeActivation    = "activation"
+	ScopeMagicLink     = "magic-link"
 	ScopePasswordReset = "password-reset"
 	ScopeRefresh       = "refresh"
+	ScopeUnlock        = "unlock"
 )
 
+// setPassword checks the password against the password policy before hashing it, so every flow that sets a password
+// enforces the policy.
 func setPassword(user *data.User, plaintextPassword string) (*data.User, error) {
-	hash, err := bcrypt.GenerateFromPassword([]byte(plaintextPassword), PasswordCost)
+	if err := config.PasswordPolicy.Check(plaintextPassword, user.Name, user.Email); err != nil {
+		return nil, err
+	}
+
+	hash, err := config.PasswordHasher.Hash(plaintextPassword)
 	if err != nil {
 		return nil, fmt.Errorf("failed set password: %w", err)
 	}
@@ -33,16 +43,33 @@ func setPassword(user *data.User, plaintextPassword string) (*data.User, error)
 	return user, nil
 }
 
-func comparePasswords(user *data.User, plaintextPassword string) error {
-	if err := bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(plaintextPassword)); err != nil {
+// comparePasswords checks the password against the hash of the user. Hashes made by an older algorithm or with
+// outdated parameters are replaced once the password is known to be correct, failing to do so does not fail the login.
+func comparePasswords(ctx context.Context, q *data.Queries, user *data.User, plaintextPassword string) error {
+	if err := config.PasswordHasher.Verify(user.PasswordHash, plaintextPassword); err != nil {
 		switch {
-		case errors.Is(err, bcrypt.ErrMismatchedHashAndPassword):
+		case errors.Is(err, password.ErrMismatchedHash):
 			return ErrInvalidCredentials
 		default:
 			return fmt.Errorf("failed compare password: %w", err)
 		}
 	}
 
+	if !config.PasswordHasher.NeedsRehash(user.PasswordHash) {
+		return nil
+	}
+
+	hash, err := config.PasswordHasher.Hash(plaintextPassword)
+	if err != nil {
+		slog.ErrorCtx(ctx, "unable to rehash password", "error", err)
+		return nil
+	}
+
+	_, err = q.UpdateUser(ctx, data.UpdateUserParams{UpdatePasswordHash: true, PasswordHash: hash, ID: user.ID, Version: user.Version})
+	if err != nil {
+		slog.ErrorCtx(ctx, "unable to update rehashed password", "error", err)
+	}
+
 	return nil
 }
 
@@ -57,7 +84,35 @@ func getUserFromToken(ctx context.Context, q *data.Queries, tokenPlaintext, scop
 	return user, nil
 }
 
+// consumeToken deletes the token and returns its user. The token is looked up and deleted in one statement, so of two
+// concurrent requests with the same single use token only one gets the user.
+func consumeToken(ctx context.Context, q *data.Queries, tokenPlaintext, scope string) (*data.User, error) {
+	tokenHash := sha256.Sum256([]byte(tokenPlaintext))
+
+	userID, err := q.ConsumeToken(ctx, data.ConsumeTokenParams{Hash: tokenHash[:], Scope: scope, Expiry: time.Now()})
+	if err != nil {
+		return nil, fmt.Errorf("failed consume token: %w", err)
+	}
+
+	user, err := q.GetUserFromID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed get user from id: %w", err)
+	}
+
+	return user, nil
+}
+
 func newToken(ctx context.Context, q *data.Queries, ttl time.Duration, scope string, userID int64) (*api.TokenResponse, error) {
+	return createToken(ctx, q, data.CreateTokenParams{UserID: userID, Expiry: time.Now().Add(ttl), Scope: scope})
+}
+
+func newSessionToken(ctx context.Context, q *data.Queries, ttl time.Duration, scope string, session *data.Session) (*api.TokenResponse, error) {
+	sessionID := pgtype.Int8{Int64: session.ID, Valid: true}
+
+	return createToken(ctx, q, data.CreateTokenParams{UserID: session.UserID, Expiry: time.Now().Add(ttl), Scope: scope, SessionID: sessionID})
+}
+
+func createToken(ctx context.Context, q *data.Queries, arg data.CreateTokenParams) (*api.TokenResponse, error) {
 	const lengthRandom = 16
 	randomBytes := make([]byte, lengthRandom)
 
@@ -68,7 +123,9 @@ func newToken(ctx context.Context, q *data.Queries, ttl time.Duration, scope str
 	plaintext := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(randomBytes)
 	hash := sha256.Sum256([]byte(plaintext))
 
-	token, err := q.CreateToken(ctx, data.CreateTokenParams{Hash: hash[:], UserID: userID, Expiry: time.Now().Add(ttl), Scope: scope})
+	arg.Hash = hash[:]
+
+	token, err := q.CreateToken(ctx, arg)
 	if err != nil {
 		return nil, fmt.Errorf("failed create token: %w", err)
 	}
@@ -77,3 +134,18 @@ func newToken(ctx context.Context, q *data.Queries, ttl time.Duration, scope str
 
 	return tokenPlaintext, nil
 }
+
+func sendActivationToken(ctx context.Context, q *data.Queries, user *data.User) (*api.TokenResponse, error) {
+	activationToken, err := newToken(ctx, q, config.TokenTTL.Activation, ScopeActivation, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed new activation token: %w", err)
+	}
+
+	templateData := map[string]any{"activationToken": activationToken.Token, "expiresIn": config.TokenTTL.Activation}
+
+	if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.ActivationRequested, User: user, Data: templateData}); err != nil {
+		return nil, fmt.Errorf("failed notify activation requested: %w", err)
+	}
+
+	return activationToken, nil
+}
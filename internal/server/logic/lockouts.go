@@ -0,0 +1,147 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/notify"
+	"github.com/seanflannery10/core/internal/shared/utils"
+	"golang.org/x/exp/slog"
+)
+
+func UnlockUser(ctx context.Context, q *data.Queries, plaintext string) (*api.AcceptanceResponse, error) {
+	user, err := getUserFromToken(ctx, q, plaintext, ScopeUnlock)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrInvalidToken
+		default:
+			return nil, fmt.Errorf("failed get user from unlock token: %w", err)
+		}
+	}
+
+	if user.Disabled {
+		return nil, ErrUserDisabled
+	}
+
+	if err = q.DeleteLockout(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed delete lockout: %w", err)
+	}
+
+	if err = q.DeleteTokens(ctx, data.DeleteTokensParams{Scope: ScopeUnlock, UserID: user.ID}); err != nil {
+		return nil, fmt.Errorf("failed delete unlock tokens: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeAccountUnlocked, user.ID, nil)
+
+	acceptanceResponse := &api.AcceptanceResponse{Message: "account unlocked"}
+
+	return acceptanceResponse, nil
+}
+
+func checkLockout(ctx context.Context, q *data.Queries, userID int64) error {
+	lockout, err := q.GetLockout(ctx, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil
+		default:
+			return fmt.Errorf("failed get lockout: %w", err)
+		}
+	}
+
+	if lockout.LockedUntil.Valid && lockout.LockedUntil.Time.After(time.Now()) {
+		return ErrAccountLocked
+	}
+
+	return nil
+}
+
+// recordFailedLogin counts a failed login and locks the account once the threshold is reached, each lock doubles
+// the previous lock duration. The user is emailed an unlock token when their account is locked.
+func recordFailedLogin(ctx context.Context, q *data.Queries, user *data.User) error {
+	lockout, err := q.RecordFailedLogin(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed record failed login: %w", err)
+	}
+
+	if lockout.FailedLogins < config.Lockout.Threshold {
+		return ErrInvalidCredentials
+	}
+
+	lockedUntil := time.Now().Add(lockoutDuration(lockout.Lockouts))
+
+	if err = q.LockUser(ctx, data.LockUserParams{LockedUntil: lockedUntil, UserID: user.ID}); err != nil {
+		return fmt.Errorf("failed lock user: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeAccountLocked, user.ID, map[string]any{"locked_until": lockedUntil.UTC()})
+
+	unlockToken, err := newToken(ctx, q, config.TokenTTL.Unlock, ScopeUnlock, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed create unlock token: %w", err)
+	}
+
+	templateData := map[string]any{"unlockToken": unlockToken.Token, "lockedUntil": lockedUntil, "expiresIn": config.TokenTTL.Unlock}
+
+	if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.AccountLocked, User: user, Data: templateData}); err != nil {
+		return fmt.Errorf("failed notify account locked: %w", err)
+	}
+
+	return ErrAccountLocked
+}
+
+// recordSignIn remembers the device a user signed in from and, when enabled, emails the user about sign-ins from
+// devices not seen before. The first device a user signs in from is never reported.
+func recordSignIn(ctx context.Context, q *data.Queries, user *data.User) error {
+	if err := q.DeleteLockout(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed delete lockout: %w", err)
+	}
+
+	known, err := q.CheckKnownDevices(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed check known devices: %w", err)
+	}
+
+	client := utils.ContextGetClient(ctx)
+
+	inserted, err := q.UpsertKnownDevice(ctx, data.UpsertKnownDeviceParams{UserID: user.ID, IpAddress: client.IPAddress, UserAgent: client.UserAgent})
+	if err != nil {
+		return fmt.Errorf("failed upsert known device: %w", err)
+	}
+
+	if !config.NotifyNewSignIn || !known || !inserted {
+		return nil
+	}
+
+	templateData := map[string]any{
+		"ipAddress": client.IPAddress,
+		"userAgent": client.UserAgent,
+		"time":      time.Now(),
+	}
+
+	if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.NewSignIn, User: user, Data: templateData}); err != nil {
+		slog.ErrorCtx(ctx, "unable to notify new sign in", "error", err)
+	}
+
+	return nil
+}
+
+func lockoutDuration(lockouts int32) time.Duration {
+	duration := config.Lockout.Base
+
+	for i := int32(0); i < lockouts && duration < config.Lockout.Max; i++ {
+		duration *= 2
+	}
+
+	if duration > config.Lockout.Max {
+		return config.Lockout.Max
+	}
+
+	return duration
+}
@@ -0,0 +1,117 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	PermissionAdminAuditRead  = "admin:audit:read"
+	PermissionAdminUsersRead  = "admin:users:read"
+	PermissionAdminUsersWrite = "admin:users:write"
+	RoleAdmin                 = "admin"
+
+	// permissionAccount covers the operations on the user's own account, API keys cannot hold it.
+	permissionAccount = "account"
+)
+
+var (
+	// defaultPermissions are held by every activated user, roles grant permissions on top of these.
+	defaultPermissions = []string{
+		permissionAccount,
+		string(api.PermissionMessagesRead),
+		string(api.PermissionMessagesWrite),
+	}
+
+	// operationPermissions is the permission each authenticated operation requires, an operation missing from it is
+	// denied to everyone.
+	operationPermissions = map[string]string{
+		"AdminDeactivateUser":     PermissionAdminUsersWrite,
+		"AdminForcePasswordReset": PermissionAdminUsersWrite,
+		"AdminGetAuditEvents":     PermissionAdminAuditRead,
+		"AdminGetUser":            PermissionAdminUsersRead,
+		"AdminGetUserSessions":    PermissionAdminUsersRead,
+		"AdminGetUsers":           PermissionAdminUsersRead,
+		"AdminReactivateUser":     PermissionAdminUsersWrite,
+		"DeleteAPIKey":            permissionAccount,
+		"DeleteMessage":           string(api.PermissionMessagesWrite),
+		"DeleteSession":           permissionAccount,
+		"GetCurrentUser":          permissionAccount,
+		"GetMessage":              string(api.PermissionMessagesRead),
+		"GetUserAPIKeys":          permissionAccount,
+		"GetUserAuditEvents":      permissionAccount,
+		"GetUserMessages":         string(api.PermissionMessagesRead),
+		"GetUserSessions":         permissionAccount,
+		"NewAPIKey":               permissionAccount,
+		"NewMessage":              string(api.PermissionMessagesWrite),
+		"UpdateMessage":           string(api.PermissionMessagesWrite),
+	}
+)
+
+func CheckAPIKeyPermission(operationName string, permissions []string) error {
+	required, ok := operationPermissions[operationName]
+	if !ok {
+		return ErrPermissionDenied
+	}
+
+	if !slices.Contains(permissions, required) {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func CheckUserPermission(ctx context.Context, q *data.Queries, operationName string, userID int64) error {
+	required, ok := operationPermissions[operationName]
+	if !ok {
+		return ErrPermissionDenied
+	}
+
+	if slices.Contains(defaultPermissions, required) {
+		return nil
+	}
+
+	permissions, err := q.GetUserPermissions(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed get user permissions: %w", err)
+	}
+
+	if !slices.Contains(permissions, required) {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func GrantUserRole(ctx context.Context, q *data.Queries, email, role string) error {
+	user, err := q.GetUserFromEmail(ctx, email)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return ErrEmailNotFound
+		default:
+			return fmt.Errorf("failed get user from email (role): %w", err)
+		}
+	}
+
+	exists, err := q.CheckRole(ctx, role)
+	if err != nil {
+		return fmt.Errorf("failed check role: %w", err)
+	}
+
+	if !exists {
+		return errors.Wrap(ErrRoleNotFound, role)
+	}
+
+	if err = q.GrantUserRole(ctx, data.GrantUserRoleParams{UserID: user.ID, Name: role}); err != nil {
+		return fmt.Errorf("failed grant user role: %w", err)
+	}
+
+	return nil
+}
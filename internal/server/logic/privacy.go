@@ -0,0 +1,168 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/notify"
+	"github.com/seanflannery10/core/internal/shared/jobs"
+	"github.com/seanflannery10/core/internal/shared/utils"
+)
+
+// privacyMessage is the response of every registration and token request in privacy mode, whatever happened to the
+// email is only told to its owner.
+const privacyMessage = "if the email address can be used, instructions have been sent to it"
+
+func acceptedResponse() *api.AcceptanceResponse {
+	return &api.AcceptanceResponse{Message: privacyMessage}
+}
+
+// Registration is a registration made in privacy mode, the password has already been checked and hashed. The client
+// that registered is kept for the audit log.
+type Registration struct {
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	PasswordHash []byte `json:"password_hash"`
+	Locale       string `json:"locale"`
+	IPAddress    string `json:"ip_address"`
+	UserAgent    string `json:"user_agent"`
+}
+
+func (Registration) Kind() string { return "registration" }
+
+func deferRegistration(ctx context.Context, q *data.Queries, newUser data.CreateUserParams) error {
+	client := utils.ContextGetClient(ctx)
+
+	registration := Registration{
+		Name:         newUser.Name,
+		Email:        newUser.Email,
+		PasswordHash: newUser.PasswordHash,
+		Locale:       newUser.Locale,
+		IPAddress:    client.IPAddress,
+		UserAgent:    client.UserAgent,
+	}
+
+	if _, err := jobs.Enqueue(ctx, q, registration); err != nil {
+		return fmt.Errorf("failed enqueue registration: %w", err)
+	}
+
+	return nil
+}
+
+// HandleRegistration is the job handler of Registration. Registering an email that has an account emails the account
+// about the attempt instead.
+func HandleRegistration(ctx context.Context, tx pgx.Tx, _ *jobs.Job, registration Registration) error {
+	q := data.New(tx)
+	ctx = utils.ContextSetClient(ctx, utils.Client{IPAddress: registration.IPAddress, UserAgent: registration.UserAgent})
+
+	newUser := data.CreateUserParams{
+		Name:         registration.Name,
+		Email:        registration.Email,
+		PasswordHash: registration.PasswordHash,
+		Locale:       registration.Locale,
+	}
+
+	_, err := registerUser(ctx, q, newUser)
+	if errors.Is(err, ErrUserExists) {
+		return notifyRegistrationAttempt(ctx, q, registration.Email)
+	}
+
+	return err
+}
+
+// notifyRegistrationAttempt emails the account of email that someone tried to register with it. An account that has
+// not been activated yet is sent a new activation token, its owner most likely lost the first one.
+func notifyRegistrationAttempt(ctx context.Context, q *data.Queries, email string) error {
+	user, err := q.GetUserFromEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed get user from email (registration): %w", err)
+	}
+
+	if !user.Activated {
+		_, err = sendActivationToken(ctx, q, user)
+
+		return err
+	}
+
+	if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.RegistrationAttempted, User: user, Data: map[string]any{"name": user.Name}}); err != nil {
+		return fmt.Errorf("failed notify registration attempted: %w", err)
+	}
+
+	return nil
+}
+
+// TokenRequest is a token request made in privacy mode. The request only enqueues it, looking the email up and
+// sending whatever it gets happens in HandleTokenRequest, so unknown and known emails take the same time to answer.
+// The client that asked is kept for the audit log.
+type TokenRequest struct {
+	Scope     string `json:"scope"`
+	Email     string `json:"email"`
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+}
+
+func (TokenRequest) Kind() string { return "token_request" }
+
+func deferTokenRequest(ctx context.Context, q *data.Queries, scope, email string) error {
+	client := utils.ContextGetClient(ctx)
+
+	req := TokenRequest{Scope: scope, Email: email, IPAddress: client.IPAddress, UserAgent: client.UserAgent}
+	if _, err := jobs.Enqueue(ctx, q, req); err != nil {
+		return fmt.Errorf("failed enqueue token request: %w", err)
+	}
+
+	return nil
+}
+
+// HandleTokenRequest is the job handler of TokenRequest. An email without an account or with a disabled one is
+// dropped, an account that can't get the requested token is told why by email instead.
+func HandleTokenRequest(ctx context.Context, tx pgx.Tx, _ *jobs.Job, req TokenRequest) error {
+	q := data.New(tx)
+	ctx = utils.ContextSetClient(ctx, utils.Client{IPAddress: req.IPAddress, UserAgent: req.UserAgent})
+
+	user, err := q.GetUserFromEmail(ctx, req.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil
+		default:
+			return fmt.Errorf("failed get user from email (token request): %w", err)
+		}
+	}
+
+	// A disabled account gets nothing, only reactivating it lets its owner back in.
+	if user.Disabled {
+		return nil
+	}
+
+	switch req.Scope {
+	case ScopeActivation:
+		if user.Activated {
+			if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.AlreadyActivated, User: user, Data: map[string]any{"name": user.Name}}); err != nil {
+				return fmt.Errorf("failed notify already activated: %w", err)
+			}
+
+			return nil
+		}
+
+		_, err = sendActivationToken(ctx, q, user)
+	case ScopePasswordReset:
+		if !user.Activated {
+			_, err = sendActivationToken(ctx, q, user)
+
+			return err
+		}
+
+		_, err = sendPasswordResetToken(ctx, q, user)
+	case ScopeMagicLink:
+		_, err = sendMagicLinkToken(ctx, q, user)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownScope, req.Scope)
+	}
+
+	return err
+}
@@ -0,0 +1,127 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/shared/utils"
+)
+
+func GetUserSessions(ctx context.Context, q *data.Queries, userID int64) (*api.SessionsResponse, error) {
+	sessionsFromDB, err := q.GetUserSessions(ctx, data.GetUserSessionsParams{UserID: userID, Now: time.Now()})
+	if err != nil {
+		return nil, fmt.Errorf("failed get user sessions: %w", err)
+	}
+
+	sessions := make([]api.SessionResponse, len(sessionsFromDB))
+	for i, v := range sessionsFromDB {
+		sessions[i] = api.SessionResponse{
+			ID:         v.ID,
+			IPAddress:  v.IpAddress,
+			UserAgent:  v.UserAgent,
+			CreatedAt:  v.CreatedAt,
+			LastUsedAt: v.LastUsedAt,
+		}
+	}
+
+	sessionsResponse := &api.SessionsResponse{Sessions: sessions}
+
+	return sessionsResponse, nil
+}
+
+func DeleteSession(ctx context.Context, q *data.Queries, sid, uid int64) (*api.AcceptanceResponse, error) {
+	if err := revokeSession(ctx, q, sid, uid); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrSessionNotFound
+		default:
+			return nil, fmt.Errorf("failed revoke session: %w", err)
+		}
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeSessionRevoked, uid, map[string]any{"session_id": sid})
+
+	acceptanceResponse := &api.AcceptanceResponse{Message: "session revoked"}
+
+	return acceptanceResponse, nil
+}
+
+// newSession starts a session for the client in the context and creates its first refresh and access tokens.
+func newSession(ctx context.Context, q *data.Queries, userID int64) (refresh, access *api.TokenResponse, err error) {
+	client := utils.ContextGetClient(ctx)
+
+	session, err := q.CreateSession(ctx, data.CreateSessionParams{IpAddress: client.IPAddress, UserAgent: client.UserAgent, UserID: userID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed create session: %w", err)
+	}
+
+	return newSessionTokens(ctx, q, session)
+}
+
+// rotateSession returns the session a refresh token belongs to, refusing tokens from revoked sessions. Refresh
+// tokens issued before sessions existed are moved into a new session.
+func rotateSession(ctx context.Context, q *data.Queries, tokenHash []byte, userID int64) (*data.Session, error) {
+	sessionID, err := q.GetTokenSessionID(ctx, data.GetTokenSessionIDParams{Hash: tokenHash, Scope: ScopeRefresh})
+	if err != nil {
+		return nil, fmt.Errorf("failed get token session id: %w", err)
+	}
+
+	client := utils.ContextGetClient(ctx)
+
+	if !sessionID.Valid {
+		session, err := q.CreateSession(ctx, data.CreateSessionParams{IpAddress: client.IPAddress, UserAgent: client.UserAgent, UserID: userID})
+		if err != nil {
+			return nil, fmt.Errorf("failed create session: %w", err)
+		}
+
+		return session, nil
+	}
+
+	session, err := q.GetSession(ctx, sessionID.Int64)
+	if err != nil {
+		return nil, fmt.Errorf("failed get session: %w", err)
+	}
+
+	if session.Revoked {
+		return nil, ErrInvalidToken
+	}
+
+	if err = q.TouchSession(ctx, data.TouchSessionParams{IpAddress: client.IPAddress, UserAgent: client.UserAgent, ID: session.ID}); err != nil {
+		return nil, fmt.Errorf("failed touch session: %w", err)
+	}
+
+	return session, nil
+}
+
+func revokeSession(ctx context.Context, q *data.Queries, sid, uid int64) error {
+	session, err := q.RevokeSession(ctx, data.RevokeSessionParams{ID: sid, UserID: uid})
+	if err != nil {
+		return fmt.Errorf("failed update session: %w", err)
+	}
+
+	if err = q.DeleteSessionTokens(ctx, pgtype.Int8{Int64: session.ID, Valid: true}); err != nil {
+		return fmt.Errorf("failed delete session tokens: %w", err)
+	}
+
+	return nil
+}
+
+func newSessionTokens(ctx context.Context, q *data.Queries, session *data.Session) (refresh, access *api.TokenResponse, err error) {
+	refresh, err = newSessionToken(ctx, q, config.TokenTTL.Refresh, ScopeRefresh, session)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed create refresh token: %w", err)
+	}
+
+	access, err = newSessionToken(ctx, q, config.TokenTTL.Access, ScopeAccess, session)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed create access token: %w", err)
+	}
+
+	return refresh, access, nil
+}
@@ -0,0 +1,35 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/seanflannery10/core/internal/generated/api"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/shared/metrics"
+)
+
+// SuppressEmail stops all email to an address after a hard bounce or a complaint reported by the email provider
+// named by Source. The user with that address, if any, sees the suppression on their profile.
+func SuppressEmail(ctx context.Context, q *data.Queries, suppression data.SuppressEmailParams) error {
+	if err := q.SuppressEmail(ctx, suppression); err != nil {
+		return fmt.Errorf("failed suppress email: %w", err)
+	}
+
+	metrics.MailerSuppressions.WithLabelValues(suppression.Reason).Inc()
+
+	user, err := q.GetUserFromEmail(ctx, suppression.Email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return fmt.Errorf("failed get user from email: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeEmailSuppressed, user.ID, map[string]any{"reason": suppression.Reason, "source": suppression.Source})
+
+	return nil
+}
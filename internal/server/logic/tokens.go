@@ -10,16 +10,21 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/seanflannery10/core/internal/generated/api"
 	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/notify"
+	"github.com/seanflannery10/core/internal/shared/metrics"
 )
 
-const (
-	ttlAccessToken        = time.Hour
-	ttlActivationToken    = 3 * 24 * time.Hour
-	ttlPasswordResetToken = 45 * time.Minute
-	ttlRefreshToken       = 7 * 24 * time.Hour
-)
+// NewActivationToken emails the user a new activation token. In privacy mode the request is handed to a job, so the
+// response and the work done before it are the same whether or not the email has an account.
+func NewActivationToken(ctx context.Context, q *data.Queries, email string) (api.NewActivationTokenRes, error) {
+	if config.PrivacyMode {
+		if err := deferTokenRequest(ctx, q, ScopeActivation, email); err != nil {
+			return nil, err
+		}
+
+		return acceptedResponse(), nil
+	}
 
-func NewActivationToken(ctx context.Context, q *data.Queries, email string) (*api.TokenResponse, error) {
 	user, err := q.GetUserFromEmail(ctx, email)
 	if err != nil {
 		switch {
@@ -30,19 +35,28 @@ func NewActivationToken(ctx context.Context, q *data.Queries, email string) (*ap
 		}
 	}
 
-	if user.Activated {
-		return nil, ErrUserAlreadyActivated
+	if user.Disabled {
+		return nil, ErrUserDisabled
 	}
 
-	activationToken, err := newToken(ctx, q, ttlActivationToken, ScopeActivation, user.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed new activation token: %w", err)
+	if user.Activated {
+		return nil, ErrUserAlreadyActivated
 	}
 
-	return activationToken, nil
+	return sendActivationToken(ctx, q, user)
 }
 
-func NewPasswordResetToken(ctx context.Context, q *data.Queries, email string) (*api.TokenResponse, error) {
+// NewPasswordResetToken emails the user a password reset token. In privacy mode the request is handed to a job, so
+// the response and the work done before it are the same whether or not the email has an account.
+func NewPasswordResetToken(ctx context.Context, q *data.Queries, email string) (api.NewPasswordResetTokenRes, error) {
+	if config.PrivacyMode {
+		if err := deferTokenRequest(ctx, q, ScopePasswordReset, email); err != nil {
+			return nil, err
+		}
+
+		return acceptedResponse(), nil
+	}
+
 	user, err := q.GetUserFromEmail(ctx, email)
 	if err != nil {
 		switch {
@@ -53,43 +67,197 @@ func NewPasswordResetToken(ctx context.Context, q *data.Queries, email string) (
 		}
 	}
 
+	if user.Disabled {
+		return nil, ErrUserDisabled
+	}
+
 	if !user.Activated {
 		return nil, ErrActivationRequired
 	}
 
-	passwordResetToken, err := newToken(ctx, q, ttlPasswordResetToken, ScopePasswordReset, user.ID)
+	return sendPasswordResetToken(ctx, q, user)
+}
+
+// NewMagicLinkToken emails the user a sign in link. In privacy mode the request is handed to a job and no token is
+// returned, so the caller responds as if the link was sent.
+func NewMagicLinkToken(ctx context.Context, q *data.Queries, email string) (*api.TokenResponse, error) {
+	if config.PrivacyMode {
+		if err := deferTokenRequest(ctx, q, ScopeMagicLink, email); err != nil {
+			return nil, err
+		}
+
+		return nil, nil //nolint:nilnil
+	}
+
+	user, err := q.GetUserFromEmail(ctx, email)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrEmailNotFound
+		default:
+			return nil, fmt.Errorf("failed get user from email (magic link): %w", err)
+		}
+	}
+
+	if user.Disabled {
+		return nil, ErrUserDisabled
+	}
+
+	return sendMagicLinkToken(ctx, q, user)
+}
+
+func sendPasswordResetToken(ctx context.Context, q *data.Queries, user *data.User) (*api.TokenResponse, error) {
+	passwordResetToken, err := newToken(ctx, q, config.TokenTTL.PasswordReset, ScopePasswordReset, user.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed create password reset token: %w", err)
 	}
 
+	templateData := map[string]any{"passwordResetToken": passwordResetToken.Token, "expiresIn": config.TokenTTL.PasswordReset}
+
+	if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.PasswordResetRequested, User: user, Data: templateData}); err != nil {
+		return nil, fmt.Errorf("failed notify password reset requested: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypePasswordResetRequested, user.ID, nil)
+
 	return passwordResetToken, nil
 }
 
+func sendMagicLinkToken(ctx context.Context, q *data.Queries, user *data.User) (*api.TokenResponse, error) {
+	magicLinkToken, err := newToken(ctx, q, config.TokenTTL.MagicLink, ScopeMagicLink, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed create magic link token: %w", err)
+	}
+
+	templateData := map[string]any{"magicLinkToken": magicLinkToken.Token, "expiresIn": config.TokenTTL.MagicLink}
+
+	if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.MagicLinkRequested, User: user, Data: templateData}); err != nil {
+		return nil, fmt.Errorf("failed notify magic link requested: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeMagicLinkRequested, user.ID, nil)
+
+	return magicLinkToken, nil
+}
+
+// ExchangeMagicLinkToken signs the user in with a magic link token, proving access to the email address also
+// activates an account that has not been activated yet. The token is used up even when the sign in is refused, a
+// locked or disabled account has to ask for a new one.
+func ExchangeMagicLinkToken(ctx context.Context, q *data.Queries, plaintext string) (refresh, access *api.TokenResponse, err error) {
+	user, err := consumeToken(ctx, q, plaintext, ScopeMagicLink)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, nil, ErrInvalidToken
+		default:
+			return nil, nil, fmt.Errorf("failed consume magic link token: %w", err)
+		}
+	}
+
+	if user.Disabled {
+		return nil, nil, ErrUserDisabled
+	}
+
+	if err = checkLockout(ctx, q, user.ID); err != nil {
+		if errors.Is(err, ErrAccountLocked) {
+			recordAuditEvent(ctx, q, api.AuditEventTypeLoginFailed, user.ID, map[string]any{"method": "magic link", "reason": "account locked"})
+			metrics.Logins.WithLabelValues(metrics.LoginLocked).Inc()
+		}
+
+		return nil, nil, fmt.Errorf("failed check lockout: %w", err)
+	}
+
+	if err = q.DeleteTokens(ctx, data.DeleteTokensParams{Scope: ScopeMagicLink, UserID: user.ID}); err != nil {
+		return nil, nil, fmt.Errorf("failed delete magic link tokens: %w", err)
+	}
+
+	if !user.Activated {
+		user, err = q.UpdateUser(ctx, data.UpdateUserParams{UpdateActivated: true, Activated: true, ID: user.ID, Version: user.Version})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed update user: %w", err)
+		}
+
+		if err = q.DeleteTokens(ctx, data.DeleteTokensParams{Scope: ScopeActivation, UserID: user.ID}); err != nil {
+			return nil, nil, fmt.Errorf("failed delete activation tokens: %w", err)
+		}
+
+		if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.UserActivated, User: user, Data: map[string]any{"name": user.Name}}); err != nil {
+			return nil, nil, fmt.Errorf("failed notify user activated: %w", err)
+		}
+
+		recordAuditEvent(ctx, q, api.AuditEventTypeUserActivated, user.ID, map[string]any{"method": "magic link"})
+		metrics.Activations.Inc()
+	}
+
+	if err = recordSignIn(ctx, q, user); err != nil {
+		return nil, nil, fmt.Errorf("failed record sign in: %w", err)
+	}
+
+	refresh, access, err = newSession(ctx, q, user.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed new session: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeLoginSucceeded, user.ID, map[string]any{"method": "magic link"})
+	metrics.Logins.WithLabelValues(metrics.LoginSucceeded).Inc()
+
+	return refresh, access, nil
+}
+
 func NewRefreshToken(ctx context.Context, q *data.Queries, email, pass string) (refresh, access *api.TokenResponse, err error) {
 	user, err := q.GetUserFromEmail(ctx, email)
 	if err != nil {
 		switch {
 		case errors.Is(err, pgx.ErrNoRows):
+			recordAuditEvent(ctx, q, api.AuditEventTypeLoginFailed, 0, map[string]any{"email": email, "reason": "unknown email"})
+			metrics.Logins.WithLabelValues(metrics.LoginFailed).Inc()
+
 			return nil, nil, ErrInvalidCredentials
 		default:
 			return nil, nil, fmt.Errorf("failed get user from email (refresh): %w", err)
 		}
 	}
 
-	if err = comparePasswords(user, pass); err != nil {
+	if err = checkLockout(ctx, q, user.ID); err != nil {
+		if errors.Is(err, ErrAccountLocked) {
+			recordAuditEvent(ctx, q, api.AuditEventTypeLoginFailed, user.ID, map[string]any{"reason": "account locked"})
+			metrics.Logins.WithLabelValues(metrics.LoginLocked).Inc()
+		}
+
+		return nil, nil, fmt.Errorf("failed check lockout: %w", err)
+	}
+
+	if err = comparePasswords(ctx, q, user, pass); err != nil {
+		if errors.Is(err, ErrInvalidCredentials) {
+			recordAuditEvent(ctx, q, api.AuditEventTypeLoginFailed, user.ID, map[string]any{"reason": "invalid credentials"})
+			metrics.Logins.WithLabelValues(metrics.LoginFailed).Inc()
+
+			err = recordFailedLogin(ctx, q, user)
+		}
+
 		return nil, nil, fmt.Errorf("failed compare passwords: %w", err)
 	}
 
-	refresh, err = newToken(ctx, q, ttlRefreshToken, ScopeRefresh, user.ID)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed create refresh token: %w", err)
+	// Checked after the password, so the response only tells the owner of the account that it is disabled.
+	if user.Disabled {
+		recordAuditEvent(ctx, q, api.AuditEventTypeLoginFailed, user.ID, map[string]any{"reason": "account disabled"})
+		metrics.Logins.WithLabelValues(metrics.LoginFailed).Inc()
+
+		return nil, nil, ErrUserDisabled
+	}
+
+	if err = recordSignIn(ctx, q, user); err != nil {
+		return nil, nil, fmt.Errorf("failed record sign in: %w", err)
 	}
 
-	access, err = newToken(ctx, q, ttlAccessToken, ScopeAccess, user.ID)
+	refresh, access, err = newSession(ctx, q, user.ID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed create access token: %w", err)
+		return nil, nil, fmt.Errorf("failed new session: %w", err)
 	}
 
+	recordAuditEvent(ctx, q, api.AuditEventTypeLoginSucceeded, user.ID, nil)
+	metrics.Logins.WithLabelValues(metrics.LoginSucceeded).Inc()
+
 	return refresh, access, nil
 }
 
@@ -112,26 +280,79 @@ func NewAccessToken(ctx context.Context, q *data.Queries, tokenFromCookie string
 	}
 
 	if badToken {
-		if err = q.DeleteTokens(ctx, data.DeleteTokensParams{Scope: ScopeRefresh, UserID: user.ID}); err != nil {
-			return nil, nil, fmt.Errorf("failed deactivate refresh token: %w", err)
+		if err = revokeTokenFamily(ctx, q, tokenHash[:], user.ID); err != nil {
+			return nil, nil, fmt.Errorf("failed revoke token family: %w", err)
 		}
 
+		recordAuditEvent(ctx, q, api.AuditEventTypeRefreshTokenReused, user.ID, nil)
+		metrics.RefreshTokenReuse.Inc()
+
 		return nil, nil, ErrReusedRefreshToken
 	}
 
+	session, err := rotateSession(ctx, q, tokenHash[:], user.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed rotate session: %w", err)
+	}
+
 	if err = q.DeactivateToken(ctx, data.DeactivateTokenParams{Scope: ScopeRefresh, Hash: tokenHash[:], UserID: user.ID}); err != nil {
 		return nil, nil, fmt.Errorf("failed deactivate refresh token: %w", err)
 	}
 
-	refresh, err = newToken(ctx, q, ttlRefreshToken, ScopeRefresh, user.ID)
+	refresh, access, err = newSessionTokens(ctx, q, session)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed create refresh token: %w", err)
+		return nil, nil, fmt.Errorf("failed new session tokens: %w", err)
 	}
 
-	access, err = newToken(ctx, q, ttlAccessToken, ScopeAccess, user.ID)
+	return refresh, access, nil
+}
+
+// revokeTokenFamily revokes the session a reused refresh token belongs to, refresh tokens issued before sessions
+// existed have no family so every refresh token of the user is deleted instead.
+func revokeTokenFamily(ctx context.Context, q *data.Queries, tokenHash []byte, userID int64) error {
+	sessionID, err := q.GetTokenSessionID(ctx, data.GetTokenSessionIDParams{Hash: tokenHash, Scope: ScopeRefresh})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed create access token: %w", err)
+		return fmt.Errorf("failed get token session id: %w", err)
 	}
 
-	return refresh, access, nil
+	if !sessionID.Valid {
+		if err = q.DeleteTokens(ctx, data.DeleteTokensParams{Scope: ScopeRefresh, UserID: userID}); err != nil {
+			return fmt.Errorf("failed delete refresh tokens: %w", err)
+		}
+
+		return nil
+	}
+
+	if err = revokeSession(ctx, q, sessionID.Int64, userID); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	return nil
+}
+
+// PurgeTokens deletes expired tokens and used tokens in batches of batchSize, so no single statement holds locks on
+// many rows, and returns how many were deleted per scope. Used refresh tokens are kept until they expire because
+// NewAccessToken relies on them to detect reuse.
+func PurgeTokens(ctx context.Context, q *data.Queries, now time.Time, batchSize int32) (map[string]int64, error) {
+	deleted := make(map[string]int64)
+
+	for {
+		scopes, err := q.DeleteStaleTokens(ctx, data.DeleteStaleTokensParams{Now: now, KeepInactiveScope: ScopeRefresh, BatchSize: batchSize})
+		if err != nil {
+			return deleted, fmt.Errorf("failed delete stale tokens: %w", err)
+		}
+
+		for _, scope := range scopes {
+			deleted[scope]++
+			metrics.TokensPurged.WithLabelValues(scope).Inc()
+		}
+
+		if len(scopes) < int(batchSize) {
+			return deleted, nil
+		}
+
+		if err = ctx.Err(); err != nil {
+			return deleted, fmt.Errorf("failed purge tokens: %w", err)
+		}
+	}
 }
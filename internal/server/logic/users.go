@@ -8,7 +8,10 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/seanflannery10/core/internal/generated/api"
 	"github.com/seanflannery10/core/internal/generated/data"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/seanflannery10/core/internal/server/notify"
+	"github.com/seanflannery10/core/internal/shared/locale"
+	"github.com/seanflannery10/core/internal/shared/metrics"
+	"github.com/seanflannery10/core/internal/shared/utils"
 )
 
 func ActivateUser(ctx context.Context, q *data.Queries, plaintext string) (*api.UserResponse, error) {
@@ -22,6 +25,10 @@ func ActivateUser(ctx context.Context, q *data.Queries, plaintext string) (*api.
 		}
 	}
 
+	if user.Disabled {
+		return nil, ErrUserDisabled
+	}
+
 	user, err = q.UpdateUser(ctx, data.UpdateUserParams{UpdateActivated: true, Activated: true, ID: user.ID, Version: user.Version})
 	if err != nil {
 		return nil, fmt.Errorf("failed update user: %w", err)
@@ -31,41 +38,115 @@ func ActivateUser(ctx context.Context, q *data.Queries, plaintext string) (*api.
 		return nil, fmt.Errorf("failed delete tokens: %w", err)
 	}
 
+	if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.UserActivated, User: user, Data: map[string]any{"name": user.Name}}); err != nil {
+		return nil, fmt.Errorf("failed notify user activated: %w", err)
+	}
+
+	recordAuditEvent(ctx, q, api.AuditEventTypeUserActivated, user.ID, nil)
+	metrics.Activations.Inc()
+
 	userResponse := &api.UserResponse{Name: user.Name, Email: user.Email, Version: user.Version}
 
 	return userResponse, nil
 }
 
-func NewUser(ctx context.Context, q *data.Queries, name, email, pass string) (*api.UserResponse, *api.TokenResponse, error) {
-	ok, err := q.CheckUser(ctx, email)
+// NewUserParams is a registration. Locale is the locale of the user's emails, or the preferred language of the client
+// when it is empty.
+type NewUserParams struct {
+	Name     string
+	Email    string
+	Password string
+	Locale   string
+}
+
+// NewUser registers a user and emails them an activation token. In privacy mode the registration is handed to a job
+// once the password has been checked, so registering an email that has an account writes the same as registering a
+// new one, the account is emailed about the attempt instead. Callers pass queries bound to a transaction so the user
+// is never created without their activation email.
+func NewUser(ctx context.Context, q *data.Queries, params NewUserParams) (api.NewUserRes, error) {
+	newUser, err := prepareUser(ctx, params)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed check user: %w", err)
+		return nil, err
 	}
 
-	if ok {
-		return nil, nil, ErrUserExists
+	if config.PrivacyMode {
+		if err = deferRegistration(ctx, q, newUser); err != nil {
+			return nil, err
+		}
+
+		return acceptedResponse(), nil
+	}
+
+	user, err := registerUser(ctx, q, newUser)
+	if err != nil {
+		return nil, err
 	}
 
-	hash, err := bcrypt.GenerateFromPassword([]byte(pass), PasswordCost)
+	userResponse := &api.UserResponse{Name: user.Name, Email: user.Email, Version: user.Version}
+
+	return userResponse, nil
+}
+
+func registerUser(ctx context.Context, q *data.Queries, newUser data.CreateUserParams) (*data.User, error) {
+	user, activationToken, err := createUser(ctx, q, newUser)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed generate password: %w", err)
+		return nil, err
+	}
+
+	templateData := map[string]any{"activationToken": activationToken.Token, "expiresIn": config.TokenTTL.Activation}
+
+	if err = notify.Enqueue(ctx, q, config.Keyring, notify.Email{Event: notify.ActivationRequested, User: user, Data: templateData}); err != nil {
+		return nil, fmt.Errorf("failed notify activation requested: %w", err)
 	}
 
-	passwordHash := hash
+	return user, nil
+}
 
-	user, err := q.CreateUser(ctx, data.CreateUserParams{Name: name, Email: email, PasswordHash: passwordHash, Activated: false})
+// prepareUser checks the password against the policy and hashes it, and resolves the locale of the user's emails.
+func prepareUser(ctx context.Context, params NewUserParams) (data.CreateUserParams, error) {
+	userLocale := params.Locale
+	if userLocale == "" {
+		userLocale = locale.FromAcceptLanguage(utils.ContextGetClient(ctx).AcceptLanguage)
+	} else {
+		var err error
+		if userLocale, err = locale.Parse(userLocale); err != nil {
+			return data.CreateUserParams{}, err //nolint:wrapcheck
+		}
+	}
+
+	user, err := setPassword(&data.User{Name: params.Name, Email: params.Email}, params.Password)
+	if err != nil {
+		return data.CreateUserParams{}, fmt.Errorf("failed set password: %w", err)
+	}
+
+	return data.CreateUserParams{Name: params.Name, Email: params.Email, PasswordHash: user.PasswordHash, Locale: userLocale}, nil
+}
+
+// createUser creates an unactivated user from prepareUser's params, with an activation token.
+func createUser(ctx context.Context, q *data.Queries, newUser data.CreateUserParams) (*data.User, *api.TokenResponse, error) {
+	ok, err := q.CheckUser(ctx, newUser.Email)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed check user: %w", err)
+	}
+
+	if ok {
+		return nil, nil, ErrUserExists
+	}
+
+	user, err := q.CreateUser(ctx, newUser)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed create user: %w", err)
 	}
 
-	activationToken, err := newToken(ctx, q, ttlActivationToken, ScopeActivation, user.ID)
+	activationToken, err := newToken(ctx, q, config.TokenTTL.Activation, ScopeActivation, user.ID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed create new token: %w", err)
 	}
 
-	userResponse := &api.UserResponse{Name: user.Name, Email: user.Email, Version: user.Version}
+	recordAuditEvent(ctx, q, api.AuditEventTypeUserRegistered, user.ID, nil)
+	metrics.Registrations.Inc()
 
-	return userResponse, activationToken, nil
+	return user, activationToken, nil
 }
 
 func UpdateUserPassword(ctx context.Context, q *data.Queries, token, pass string) (*api.AcceptanceResponse, error) {
@@ -79,6 +160,10 @@ func UpdateUserPassword(ctx context.Context, q *data.Queries, token, pass string
 		}
 	}
 
+	if user.Disabled {
+		return nil, ErrUserDisabled
+	}
+
 	user, err = setPassword(user, pass)
 	if err != nil {
 		return nil, fmt.Errorf("failed set password: %w", err)
@@ -98,7 +183,41 @@ func UpdateUserPassword(ctx context.Context, q *data.Queries, token, pass string
 		return nil, fmt.Errorf("failed delete password reset token: %w", err)
 	}
 
+	recordAuditEvent(ctx, q, api.AuditEventTypePasswordChanged, user.ID, nil)
+
 	acceptanceResponse := &api.AcceptanceResponse{Message: "password updated"}
 
 	return acceptanceResponse, nil
 }
+
+// GetCurrentUser returns the profile of userID, with the suppression of their email address when there is one.
+func GetCurrentUser(ctx context.Context, q *data.Queries, userID int64) (*api.UserProfileResponse, error) {
+	user, err := q.GetUserFromID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed get user from id: %w", err)
+	}
+
+	profile := &api.UserProfileResponse{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Locale:    user.Locale,
+		Activated: user.Activated,
+		CreatedAt: user.CreatedAt,
+		Version:   user.Version,
+	}
+
+	suppression, err := q.GetEmailSuppression(ctx, user.Email)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed get email suppression: %w", err)
+	}
+
+	if err == nil {
+		profile.EmailSuppression = api.NewOptEmailSuppression(api.EmailSuppression{
+			Reason: api.EmailSuppressionReason(suppression.Reason),
+			Since:  suppression.CreatedAt,
+		})
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,146 @@
+// Package notify emails users about domain events. Logic enqueues a Notification with the queries it wrote the change
+// through and the job worker sends it. The queries of the API autocommit, so a failed enqueue leaves the change
+// without its email, callers that need both or neither pass queries bound to a transaction. Adding an email only
+// takes a template and an entry in templates.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/shared/jobs"
+	"github.com/seanflannery10/core/internal/shared/keyring"
+	"github.com/seanflannery10/core/internal/shared/mailer"
+	"golang.org/x/exp/slog"
+)
+
+type Event string
+
+const (
+	AccountLocked          Event = "account_locked"
+	ActivationRequested    Event = "activation_requested"
+	AlreadyActivated       Event = "already_activated"
+	MagicLinkRequested     Event = "magic_link_requested"
+	NewSignIn              Event = "new_sign_in"
+	PasswordResetRequested Event = "password_reset_requested"
+	RegistrationAttempted  Event = "registration_attempted"
+	UserActivated          Event = "user_activated"
+)
+
+// sealName binds sealed template data to notifications.
+const sealName = "notification"
+
+var ErrUnknownEvent = errors.New("unknown notification event")
+
+// templates maps each event to the mailer template sent for it.
+var templates = map[Event]string{
+	AccountLocked:          "token_unlock.tmpl",
+	ActivationRequested:    "token_activation.tmpl",
+	AlreadyActivated:       "user_already_activated.tmpl",
+	MagicLinkRequested:     "token_magic_link.tmpl",
+	NewSignIn:              "user_new_sign_in.tmpl",
+	PasswordResetRequested: "token_password_reset.tmpl",
+	RegistrationAttempted:  "user_registration_attempt.tmpl",
+	UserActivated:          "user_welcome.tmpl",
+}
+
+type (
+	// Email is an email about Event to User, Data is the data of its template.
+	Email struct {
+		Event Event
+		User  *data.User
+		Data  map[string]any
+	}
+	// Notification is the job args of an email. Data is the sealed template data of the recipient's locale, it holds
+	// plaintext tokens that must not be readable from the jobs table.
+	Notification struct {
+		Event     Event  `json:"event"`
+		Recipient string `json:"recipient"`
+		Locale    string `json:"locale"`
+		Data      string `json:"data"`
+	}
+	Notifier struct {
+		mailer  mailer.Mailer
+		keyring *keyring.Keyring
+	}
+)
+
+func (Notification) Kind() string { return "notification" }
+
+// NewNotification seals the template data of email into a notification.
+func NewNotification(keys *keyring.Keyring, email Email) (Notification, error) {
+	if _, ok := templates[email.Event]; !ok {
+		return Notification{}, fmt.Errorf("%w: %s", ErrUnknownEvent, email.Event)
+	}
+
+	encoded, err := json.Marshal(email.Data)
+	if err != nil {
+		return Notification{}, fmt.Errorf("failed encode template data: %w", err)
+	}
+
+	sealed, err := keys.Seal(sealName, string(encoded))
+	if err != nil {
+		return Notification{}, fmt.Errorf("failed seal template data: %w", err)
+	}
+
+	return Notification{Event: email.Event, Recipient: email.User.Email, Locale: email.User.Locale, Data: sealed}, nil
+}
+
+// Enqueue queues email.
+func Enqueue(ctx context.Context, q *data.Queries, keys *keyring.Keyring, email Email) error {
+	notification, err := NewNotification(keys, email)
+	if err != nil {
+		return err
+	}
+
+	if _, err = jobs.Enqueue(ctx, q, notification); err != nil {
+		return fmt.Errorf("failed enqueue notification: %w", err)
+	}
+
+	return nil
+}
+
+func New(m mailer.Mailer, keys *keyring.Keyring) *Notifier {
+	return &Notifier{mailer: m, keyring: keys}
+}
+
+// Send is the job handler of notifications, a failed send is retried by the worker. Emails to suppressed recipients
+// are dropped, the suppression list is read through the job's transaction so a send holds a single pool connection.
+func (n *Notifier) Send(ctx context.Context, tx pgx.Tx, _ *jobs.Job, notification Notification) error {
+	tmpl, ok := templates[notification.Event]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownEvent, notification.Event)
+	}
+
+	suppressed, err := data.New(tx).CheckEmailSuppressed(ctx, notification.Recipient)
+	if err != nil {
+		return fmt.Errorf("failed check email suppressed: %w", err)
+	}
+
+	// Retrying cannot reach a suppressed address, the job is done.
+	if suppressed {
+		slog.InfoCtx(ctx, "skipped email to suppressed recipient", "event", notification.Event)
+		return nil
+	}
+
+	opened, err := n.keyring.Open(sealName, notification.Data)
+	if err != nil {
+		return fmt.Errorf("failed open template data: %w", err)
+	}
+
+	var templateData map[string]any
+
+	if err = json.Unmarshal([]byte(opened), &templateData); err != nil {
+		return fmt.Errorf("failed decode template data: %w", err)
+	}
+
+	if err = n.mailer.Send(ctx, notification.Recipient, notification.Locale, tmpl, templateData); err != nil {
+		return fmt.Errorf("failed send %s email: %w", notification.Event, err)
+	}
+
+	return nil
+}
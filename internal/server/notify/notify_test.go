@@ -0,0 +1,131 @@
+package notify_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/seanflannery10/core/internal/generated/data"
+	"github.com/seanflannery10/core/internal/server/notify"
+	"github.com/seanflannery10/core/internal/shared/keyring"
+	"github.com/seanflannery10/core/internal/shared/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKeyring(t *testing.T) *keyring.Keyring {
+	t.Helper()
+
+	keys, err := keyring.New(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+
+	return keys
+}
+
+// newNotification seals data like Enqueue does.
+func newNotification(t *testing.T, keys *keyring.Keyring, event notify.Event, email string, templateData map[string]any) notify.Notification {
+	t.Helper()
+
+	notification, err := notify.NewNotification(keys, notify.Email{Event: event, User: &data.User{Email: email, Locale: "en"}, Data: templateData})
+	require.NoError(t, err)
+
+	return notification
+}
+
+type (
+	// suppressionTx answers the suppression check of Send, the only query it makes through the job's transaction.
+	suppressionTx struct {
+		pgx.Tx
+		suppressed bool
+	}
+	boolRow bool
+)
+
+func (tx suppressionTx) QueryRow(context.Context, string, ...any) pgx.Row {
+	return boolRow(tx.suppressed)
+}
+
+func (r boolRow) Scan(dest ...any) error {
+	*dest[0].(*bool) = bool(r) //nolint:forcetypeassert
+
+	return nil
+}
+
+func TestEnqueue_UnknownEvent(t *testing.T) {
+	err := notify.Enqueue(context.Background(), data.New(nil), newKeyring(t), notify.Email{Event: "unknown", User: &data.User{Email: "user@test.com"}})
+	assert.ErrorIs(t, err, notify.ErrUnknownEvent)
+}
+
+func TestNewNotification_Sealed(t *testing.T) {
+	notification := newNotification(t, newKeyring(t), notify.PasswordResetRequested, "user@test.com",
+		map[string]any{"passwordResetToken": "ABCDEFGHIJ"})
+
+	encoded, err := json.Marshal(notification)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), "ABCDEFGHIJ", "job args must not hold the plaintext token")
+
+	err = notify.New(nil, newKeyring(t)).Send(context.Background(), suppressionTx{}, nil, notify.Notification{
+		Event: notify.PasswordResetRequested, Recipient: "user@test.com", Data: "not sealed",
+	})
+	assert.Error(t, err)
+}
+
+func TestNotifier_Send(t *testing.T) {
+	memory := mailer.NewMemory()
+
+	m, err := mailer.NewWithTransport(mailer.Config{Sender: "Test <no-reply@testdomain.com>"}, memory)
+	require.NoError(t, err)
+
+	keys := newKeyring(t)
+
+	// Template data is sealed as JSON, so durations arrive as numbers.
+	notification := newNotification(t, keys, notify.PasswordResetRequested, "user@test.com",
+		map[string]any{"passwordResetToken": "ABCDEFGHIJ", "expiresIn": 45 * time.Minute})
+
+	err = notify.New(m, keys).Send(context.Background(), suppressionTx{}, nil, notification)
+	require.NoError(t, err)
+
+	messages := memory.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "user@test.com", messages[0].To)
+	assert.Contains(t, messages[0].PlainBody, "ABCDEFGHIJ")
+	assert.Contains(t, messages[0].PlainBody, "45 minutes")
+}
+
+func TestNotifier_Send_Suppressed(t *testing.T) {
+	memory := mailer.NewMemory()
+
+	m, err := mailer.NewWithTransport(mailer.Config{Sender: "Test <no-reply@testdomain.com>"}, memory)
+	require.NoError(t, err)
+
+	keys := newKeyring(t)
+
+	// A suppressed recipient completes the job instead of failing it, retries would never succeed.
+	notification := newNotification(t, keys, notify.UserActivated, "bounced@test.com", map[string]any{"name": "Test"})
+
+	err = notify.New(m, keys).Send(context.Background(), suppressionTx{suppressed: true}, nil, notification)
+	require.NoError(t, err)
+	assert.Empty(t, memory.Messages())
+}
+
+func TestNotifier_Send_RegistrationAttempted(t *testing.T) {
+	memory := mailer.NewMemory()
+
+	m, err := mailer.NewWithTransport(mailer.Config{Sender: "Test <no-reply@testdomain.com>"}, memory)
+	require.NoError(t, err)
+
+	keys := newKeyring(t)
+
+	notification := newNotification(t, keys, notify.RegistrationAttempted, "user@test.com", map[string]any{"name": "Test"})
+
+	err = notify.New(m, keys).Send(context.Background(), suppressionTx{}, nil, notification)
+	require.NoError(t, err)
+
+	messages := memory.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "Someone tried to register with your email address", messages[0].Subject)
+	assert.Contains(t, messages[0].PlainBody, "Hi Test,")
+}
@@ -0,0 +1,154 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-faster/jx"
+)
+
+const (
+	statusFailing      = "failing"
+	statusOK           = "ok"
+	statusShuttingDown = "shutting down"
+
+	defaultTimeout = 2 * time.Second
+)
+
+type (
+	// Check is a dependency that must be reachable for the server to be ready, Run must return once ctx is done.
+	Check struct {
+		Name    string
+		Timeout time.Duration
+		Run     func(ctx context.Context) error
+	}
+
+	// Checker serves the liveness and readiness endpoints.
+	Checker struct {
+		checks       []Check
+		shuttingDown atomic.Bool
+	}
+
+	result struct {
+		name     string
+		err      error
+		duration time.Duration
+	}
+)
+
+func New(checks ...Check) *Checker {
+	return &Checker{checks: checks}
+}
+
+// Shutdown makes every later readiness check fail, so load balancers stop routing requests before the server stops.
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+}
+
+// Liveness reports that the process is running and able to serve requests.
+func (c *Checker) Liveness(w http.ResponseWriter, _ *http.Request) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+
+	e.ObjStart()
+	e.FieldStart("status")
+	e.Str(statusOK)
+	e.ObjEnd()
+
+	writeJSON(w, http.StatusOK, e.Bytes())
+}
+
+// Readiness runs every check concurrently and reports the result of each, it fails when any check fails. Once the
+// server is shutting down it fails without running the checks.
+func (c *Checker) Readiness(w http.ResponseWriter, r *http.Request) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+
+	if c.shuttingDown.Load() {
+		e.ObjStart()
+		e.FieldStart("status")
+		e.Str(statusShuttingDown)
+		e.ObjEnd()
+
+		writeJSON(w, http.StatusServiceUnavailable, e.Bytes())
+
+		return
+	}
+
+	results := c.run(r.Context())
+
+	code, status := http.StatusOK, statusOK
+
+	e.ObjStart()
+	e.FieldStart("checks")
+	e.ObjStart()
+
+	for _, v := range results {
+		e.FieldStart(v.name)
+		e.ObjStart()
+		e.FieldStart("status")
+
+		if v.err != nil {
+			code, status = http.StatusServiceUnavailable, statusFailing
+
+			e.Str(statusFailing)
+			e.FieldStart("error")
+			e.Str(v.err.Error())
+		} else {
+			e.Str(statusOK)
+		}
+
+		e.FieldStart("duration_ms")
+		e.Int64(v.duration.Milliseconds())
+		e.ObjEnd()
+	}
+
+	e.ObjEnd()
+	e.FieldStart("status")
+	e.Str(status)
+	e.ObjEnd()
+
+	writeJSON(w, code, e.Bytes())
+}
+
+func (c *Checker) run(ctx context.Context) []result {
+	results := make([]result, len(c.checks))
+
+	var wg sync.WaitGroup
+
+	for i, check := range c.checks {
+		wg.Add(1)
+
+		go func(i int, check Check) {
+			defer wg.Done()
+
+			timeout := check.Timeout
+			if timeout == 0 {
+				timeout = defaultTimeout
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Run(ctx)
+
+			results[i] = result{name: check.Name, err: err, duration: time.Since(start)}
+		}(i, check)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+func writeJSON(w http.ResponseWriter, code int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,87 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/shared/health"
+	"github.com/stretchr/testify/assert"
+)
+
+type readiness struct {
+	Status string `json:"status"`
+	Checks map[string]struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+	} `json:"checks"`
+}
+
+func TestChecker_Liveness(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	health.New().Liveness(rr, httptest.NewRequest(http.MethodGet, "/healthz", http.NoBody))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rr.Body.String())
+}
+
+func TestChecker_Readiness(t *testing.T) {
+	ok := health.Check{Name: "ok", Run: func(context.Context) error { return nil }}
+	failing := health.Check{Name: "failing", Run: func(context.Context) error { return errors.New("unreachable") }}
+	slow := health.Check{Name: "slow", Timeout: 10 * time.Millisecond, Run: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}}
+
+	tests := []struct {
+		Name       string
+		Checker    *health.Checker
+		StatusCode int
+		Status     string
+	}{
+		{Name: "Ready", Checker: health.New(ok), StatusCode: http.StatusOK, Status: "ok"},
+		{Name: "Failing", Checker: health.New(ok, failing), StatusCode: http.StatusServiceUnavailable, Status: "failing"},
+		{Name: "Timeout", Checker: health.New(ok, slow), StatusCode: http.StatusServiceUnavailable, Status: "failing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+
+			tt.Checker.Readiness(rr, httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody))
+
+			var body readiness
+			if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.StatusCode, rr.Code)
+			assert.Equal(t, tt.Status, body.Status)
+			assert.Equal(t, "ok", body.Checks["ok"].Status)
+		})
+	}
+}
+
+func TestChecker_Shutdown(t *testing.T) {
+	ran := false
+	failing := health.Check{Name: "failing", Run: func(context.Context) error {
+		ran = true
+		return errors.New("unreachable")
+	}}
+
+	checker := health.New(failing)
+	checker.Shutdown()
+
+	rr := httptest.NewRecorder()
+
+	checker.Readiness(rr, httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.JSONEq(t, `{"status":"shutting down"}`, rr.Body.String())
+	assert.False(t, ran)
+}
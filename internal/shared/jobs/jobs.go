@@ -0,0 +1,93 @@
+// Package jobs is a job queue stored in Postgres. Jobs are enqueued with the queries of the connection or transaction
+// the caller writes through, a job enqueued with the queries of a transaction exists exactly when that transaction
+// commits. Workers claim jobs with SELECT ... FOR UPDATE SKIP LOCKED and hold the row lock while the handler runs, a
+// crashed worker therefore releases its job to the other workers.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/seanflannery10/core/internal/generated/data"
+)
+
+const defaultMaxAttempts = 5
+
+var ErrDuplicate = errors.New("unique job already pending")
+
+type (
+	// Args are the arguments of a job, they are stored as JSON and Kind selects the handler.
+	Args interface {
+		Kind() string
+	}
+	// Job is a claimed job as seen by its handler.
+	Job struct {
+		ID          int64
+		Kind        string
+		Attempt     int32
+		MaxAttempts int32
+	}
+	EnqueueOption  func(*enqueueOptions)
+	enqueueOptions struct {
+		runAt       time.Time
+		uniqueKey   pgtype.Text
+		maxAttempts int32
+	}
+)
+
+// RunAt delays the job until t.
+func RunAt(t time.Time) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.runAt = t
+	}
+}
+
+// Unique skips the insert with ErrDuplicate while a job of the same kind and key is pending.
+func Unique(key string) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.uniqueKey = pgtype.Text{String: key, Valid: true}
+	}
+}
+
+// MaxAttempts overrides how often the job runs before it is marked failed, the default is 5.
+func MaxAttempts(n int32) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.maxAttempts = n
+	}
+}
+
+// Enqueue inserts a job and returns its ID. Pass the queries of the transaction of the business write to enqueue
+// atomically.
+func Enqueue(ctx context.Context, q *data.Queries, args Args, opts ...EnqueueOption) (int64, error) {
+	o := &enqueueOptions{runAt: time.Now(), maxAttempts: defaultMaxAttempts}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return 0, fmt.Errorf("failed encode job args: %w", err)
+	}
+
+	id, err := q.EnqueueJob(ctx, data.EnqueueJobParams{
+		Kind:        args.Kind(),
+		Args:        encoded,
+		UniqueKey:   o.uniqueKey,
+		RunAt:       o.runAt,
+		MaxAttempts: o.maxAttempts,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrDuplicate
+		}
+
+		return 0, fmt.Errorf("failed insert job: %w", err)
+	}
+
+	return id, nil
+}
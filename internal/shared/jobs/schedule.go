@@ -0,0 +1,228 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/seanflannery10/core/internal/generated/data"
+)
+
+var ErrInvalidCron = errors.New("invalid cron expression")
+
+type (
+	// Schedule returns the next time a periodic job runs after t.
+	Schedule interface {
+		Next(t time.Time) time.Time
+	}
+	every    time.Duration
+	schedule struct {
+		name     string
+		schedule Schedule
+		args     Args
+	}
+	// cron matches times in UTC, each field is a bit set of the values it matches.
+	cron struct {
+		minute, hour, dom, month, dow uint64
+		domAny, dowAny                bool
+	}
+)
+
+// Every runs a periodic job at a fixed interval.
+func Every(d time.Duration) Schedule {
+	return every(d)
+}
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
+
+// Periodic enqueues a job with args on schedule. The name identifies the schedule in the database, every replica may
+// register the same schedule and only one of them enqueues each run. Periodic must be called before Start.
+func (w *Worker) Periodic(name string, s Schedule, args Args) {
+	w.schedules = append(w.schedules, schedule{name: name, schedule: s, args: args})
+}
+
+// enqueuePeriodic claims every schedule that is due by moving its next run forward and enqueues its job in the same
+// transaction. The advisory lock lets other replicas skip a schedule that is being claimed instead of waiting on its
+// row. It never reports finding work, so it runs once per poll interval.
+func (w *Worker) enqueuePeriodic(ctx context.Context) (bool, error) {
+	for _, s := range w.schedules {
+		now := time.Now()
+
+		err := pgx.BeginFunc(ctx, w.pool, func(tx pgx.Tx) error {
+			q := data.New(tx)
+
+			locked, err := q.TryLockSchedule(ctx, s.name)
+			if err != nil {
+				return fmt.Errorf("failed try advisory lock: %w", err)
+			}
+
+			if !locked {
+				return nil
+			}
+
+			if err = q.InsertJobSchedule(ctx, data.InsertJobScheduleParams{Name: s.name, NextRunAt: s.schedule.Next(now)}); err != nil {
+				return fmt.Errorf("failed insert schedule: %w", err)
+			}
+
+			claimed, err := q.ClaimJobSchedule(ctx, data.ClaimJobScheduleParams{Name: s.name, NextRunAt: s.schedule.Next(now), Now: now})
+			if err != nil {
+				return fmt.Errorf("failed claim schedule: %w", err)
+			}
+
+			if claimed == 0 {
+				return nil
+			}
+
+			if _, err = Enqueue(ctx, q, s.args, Unique(s.name)); err != nil && !errors.Is(err, ErrDuplicate) {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed enqueue periodic job %s: %w", s.name, err)
+		}
+	}
+
+	return false, nil
+}
+
+var cronDescriptors = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+	"@yearly":  "0 0 1 1 *",
+}
+
+// ParseCron parses a standard five field cron expression, minute hour day-of-month month day-of-week, with *, lists,
+// ranges and steps. It also accepts the @hourly, @daily, @weekly, @monthly, @yearly and "@every <duration>" shorthands.
+func ParseCron(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	if strings.HasPrefix(spec, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidCron, spec)
+		}
+
+		return Every(d), nil
+	}
+
+	if expanded, ok := cronDescriptors[spec]; ok {
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 { //nolint:gomnd
+		return nil, fmt.Errorf("%w: %q: expected 5 fields", ErrInvalidCron, spec)
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}} //nolint:gomnd
+	sets := [5]uint64{}
+
+	for i, field := range fields {
+		set, err := parseCronField(field, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidCron, spec, err) //nolint:errorlint
+		}
+
+		sets[i] = set
+	}
+
+	return cron{
+		minute: sets[0], hour: sets[1], dom: sets[2], month: sets[3], dow: sets[4],
+		domAny: fields[2] == "*", dowAny: fields[4] == "*",
+	}, nil
+}
+
+func parseCronField(field string, low, high int) (uint64, error) {
+	var set uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+
+		start, end := low, high
+
+		if rangePart != "*" {
+			first, last, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+			if start, err = strconv.Atoi(first); err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+
+			end = start
+
+			if isRange {
+				if end, err = strconv.Atoi(last); err != nil {
+					return 0, fmt.Errorf("invalid range %q", part)
+				}
+			} else if hasStep {
+				end = high
+			}
+		}
+
+		if start < low || end > high || start > end {
+			return 0, fmt.Errorf("%q out of range %d-%d", part, low, high)
+		}
+
+		for v := start; v <= end; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+
+	return set, nil
+}
+
+// Next finds the first matching minute after t by skipping whole months, days and hours that cannot match.
+func (c cron) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+
+	// Every schedule matches within five years, the limit only guards against expressions like "0 0 30 2 *".
+	limit := t.AddDate(5, 0, 0) //nolint:gomnd
+
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// dayMatches follows cron in matching either day field when both are restricted.
+func (c cron) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+
+	return dom || dow
+}
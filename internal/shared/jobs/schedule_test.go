@@ -0,0 +1,57 @@
+package jobs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/seanflannery10/core/internal/shared/jobs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCron(t *testing.T) {
+	start := time.Date(2023, time.April, 25, 10, 30, 15, 0, time.UTC) // a Tuesday
+
+	tests := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2023, time.April, 25, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2023, time.April, 25, 10, 45, 0, 0, time.UTC)},
+		{"0 9-17 * * *", time.Date(2023, time.April, 25, 11, 0, 0, 0, time.UTC)},
+		{"30 2 * * *", time.Date(2023, time.April, 26, 2, 30, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 1-5/2", time.Date(2023, time.April, 26, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 5", time.Date(2023, time.April, 28, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2023, time.April, 25, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2023, time.April, 30, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		{"@every 90s", start.Add(90 * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := jobs.ParseCron(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.expected, s.Next(start))
+		})
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "a * * * *", "@every -1m", "@never"} {
+		t.Run(spec, func(t *testing.T) {
+			_, err := jobs.ParseCron(spec)
+			assert.ErrorIs(t, err, jobs.ErrInvalidCron)
+		})
+	}
+}
+
+func TestEvery(t *testing.T) {
+	start := time.Date(2023, time.April, 25, 10, 30, 15, 0, time.UTC)
+
+	assert.Equal(t, start.Add(time.Hour), jobs.Every(time.Hour).Next(start))
+}
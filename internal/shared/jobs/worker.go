@@ -0,0 +1,281 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/seanflannery10/core/internal/shared/metrics"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	defaultConcurrency  = 4
+	defaultPollInterval = time.Second
+	backoffBase         = 10 * time.Second
+	backoffMax          = time.Hour
+)
+
+var errPanic = errors.New("job handler panicked")
+
+type (
+	// Worker runs the handlers registered with Handle. Each of its goroutines holds a pool connection while it runs
+	// a job.
+	Worker struct {
+		pool         *pgxpool.Pool
+		handlers     map[string]func(context.Context, pgx.Tx, *Job, []byte) error
+		schedules    []schedule
+		concurrency  int
+		pollInterval time.Duration
+		backoff      func(attempt int32) time.Duration
+		stop         chan struct{}
+		cancel       context.CancelFunc
+		wg           sync.WaitGroup
+	}
+	Option func(*Worker)
+)
+
+// WithConcurrency sets how many jobs run at the same time, the default is 4.
+func WithConcurrency(n int) Option {
+	return func(w *Worker) {
+		w.concurrency = n
+	}
+}
+
+// WithPollInterval sets how long an idle worker waits before looking for jobs again, the default is one second.
+func WithPollInterval(d time.Duration) Option {
+	return func(w *Worker) {
+		w.pollInterval = d
+	}
+}
+
+// WithBackoff replaces the delay before a failed job is retried, the default doubles from 10 seconds up to an hour.
+func WithBackoff(backoff func(attempt int32) time.Duration) Option {
+	return func(w *Worker) {
+		w.backoff = backoff
+	}
+}
+
+func NewWorker(pool *pgxpool.Pool, opts ...Option) *Worker {
+	w := &Worker{
+		pool:         pool,
+		handlers:     make(map[string]func(context.Context, pgx.Tx, *Job, []byte) error),
+		concurrency:  defaultConcurrency,
+		pollInterval: defaultPollInterval,
+		backoff:      exponentialBackoff,
+		stop:         make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w
+}
+
+// Handle registers fn for jobs with args of type T. It runs inside a savepoint of the transaction holding the job, so
+// its writes through tx commit together with the job's completion. Returning an error retries the job with backoff.
+// Handle must be called before Start.
+func Handle[T Args](w *Worker, fn func(ctx context.Context, tx pgx.Tx, job *Job, args T) error) {
+	var zero T
+
+	w.handlers[zero.Kind()] = func(ctx context.Context, tx pgx.Tx, job *Job, encoded []byte) error {
+		var args T
+
+		if err := json.Unmarshal(encoded, &args); err != nil {
+			return fmt.Errorf("failed decode job args: %w", err)
+		}
+
+		return fn(ctx, tx, job, args)
+	}
+}
+
+// Start runs the worker in the background until Shutdown.
+func (w *Worker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	for i := 0; i < w.concurrency; i++ {
+		w.wg.Add(1)
+
+		go func() {
+			defer w.wg.Done()
+			w.loop(ctx, w.work)
+		}()
+	}
+
+	if len(w.schedules) > 0 {
+		w.wg.Add(1)
+
+		go func() {
+			defer w.wg.Done()
+			w.loop(ctx, w.enqueuePeriodic)
+		}()
+	}
+}
+
+// Shutdown stops claiming jobs and waits for running jobs to finish. When ctx ends first, running handlers are
+// cancelled and their jobs are left for another worker.
+func (w *Worker) Shutdown(ctx context.Context) error {
+	close(w.stop)
+
+	if w.cancel == nil {
+		return nil
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		w.cancel()
+		return nil
+	case <-ctx.Done():
+		w.cancel()
+		<-done
+
+		return fmt.Errorf("failed drain jobs: %w", ctx.Err())
+	}
+}
+
+// loop calls fn until Shutdown, waiting for the poll interval whenever fn found nothing to do.
+func (w *Worker) loop(ctx context.Context, fn func(context.Context) (bool, error)) {
+	for {
+		select {
+		case <-w.stop:
+			return
+		default:
+		}
+
+		found, err := fn(ctx)
+		if err != nil {
+			slog.Error("unable to process jobs", "error", err)
+		}
+
+		if found && err == nil {
+			continue
+		}
+
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// work claims and runs a single job, it reports whether there was a job to run.
+func (w *Worker) work(ctx context.Context) (bool, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed begin transaction: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	job := &Job{}
+
+	var args []byte
+
+	err = tx.QueryRow(ctx, `SELECT id, kind, args, attempts + 1, max_attempts
+FROM jobs
+WHERE state = 'pending'
+  AND run_at <= $1
+  AND kind = ANY ($2)
+ORDER BY run_at, id
+LIMIT 1 FOR UPDATE SKIP LOCKED`, time.Now(), maps.Keys(w.handlers)).
+		Scan(&job.ID, &job.Kind, &args, &job.Attempt, &job.MaxAttempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed claim job: %w", err)
+	}
+
+	start := time.Now()
+	runErr := w.run(ctx, tx, job, args)
+
+	metrics.JobDuration.WithLabelValues(job.Kind).Observe(time.Since(start).Seconds())
+
+	logger := slog.With("job_id", job.ID, "kind", job.Kind, "attempt", job.Attempt)
+
+	switch {
+	case runErr == nil:
+		_, err = tx.Exec(ctx, "DELETE FROM jobs WHERE id = $1", job.ID)
+
+		metrics.JobsProcessed.WithLabelValues(job.Kind, metrics.JobSucceeded).Inc()
+	case job.Attempt >= job.MaxAttempts:
+		_, err = tx.Exec(ctx, "UPDATE jobs SET state = 'failed', attempts = $2, last_error = $3 WHERE id = $1",
+			job.ID, job.Attempt, runErr.Error())
+
+		logger.Error("job failed permanently", "error", runErr)
+		metrics.JobsProcessed.WithLabelValues(job.Kind, metrics.JobFailed).Inc()
+	default:
+		_, err = tx.Exec(ctx, "UPDATE jobs SET attempts = $2, last_error = $3, run_at = $4 WHERE id = $1",
+			job.ID, job.Attempt, runErr.Error(), time.Now().Add(w.backoff(job.Attempt)))
+
+		logger.Warn("job failed, retrying", "error", runErr)
+		metrics.JobsProcessed.WithLabelValues(job.Kind, metrics.JobRetried).Inc()
+	}
+
+	if err != nil {
+		return true, fmt.Errorf("failed update job: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return true, fmt.Errorf("failed commit job: %w", err)
+	}
+
+	return true, nil
+}
+
+// run calls the handler in a savepoint, so a failed handler's writes are rolled back while the attempt is recorded.
+func (w *Worker) run(ctx context.Context, tx pgx.Tx, job *Job, args []byte) (err error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed begin savepoint: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("%w: %v", errPanic, p)
+		}
+
+		if err != nil {
+			_ = savepoint.Rollback(ctx)
+		}
+	}()
+
+	if err = w.handlers[job.Kind](ctx, savepoint, job, args); err != nil {
+		return err
+	}
+
+	return savepoint.Commit(ctx) //nolint:wrapcheck
+}
+
+func exponentialBackoff(attempt int32) time.Duration {
+	backoff := backoffBase
+
+	for i := int32(1); i < attempt && backoff < backoffMax; i++ {
+		backoff *= 2
+	}
+
+	if backoff > backoffMax {
+		backoff = backoffMax
+	}
+
+	// Jitter of up to a tenth keeps jobs that failed together from retrying together.
+	return backoff + time.Duration(rand.Int63n(int64(backoff/10))) //nolint:gosec
+}
@@ -0,0 +1,150 @@
+package keyring
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// keyIDLength is the number of bytes of the key's SHA-256 digest that identify it in sealed values.
+const keyIDLength = 4
+
+var (
+	ErrDuplicateKey = errors.New("duplicate key")
+	ErrInvalidValue = errors.New("invalid sealed value")
+	ErrNameMismatch = errors.New("sealed value belongs to another name")
+	ErrNoKey        = errors.New("keyring needs an active key")
+)
+
+// Keyring seals values with its active key and opens values sealed with any of its keys, so a key can be rotated
+// by making the new key active and keeping the old one until everything it sealed has expired.
+//
+// A sealed value is the base64 url encoding of the key ID, the nonce and the AES-GCM ciphertext of "name:value".
+// The key ID is the start of the SHA-256 digest of the key, it is authenticated as additional data.
+type Keyring struct {
+	active []byte
+	keys   map[string]cipher.AEAD
+}
+
+// New returns a keyring that seals with active and also opens values sealed with any of previous. Keys must be 16,
+// 24 or 32 bytes long to select AES-128, AES-192 or AES-256.
+func New(active []byte, previous ...[]byte) (*Keyring, error) {
+	if len(active) == 0 {
+		return nil, ErrNoKey
+	}
+
+	k := &Keyring{keys: make(map[string]cipher.AEAD, len(previous)+1)}
+
+	for i, key := range append([][]byte{active}, previous...) {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed new cipher for key %d: %w", i, err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed new gcm for key %d: %w", i, err)
+		}
+
+		id := keyID(key)
+
+		if _, ok := k.keys[string(id)]; ok {
+			return nil, fmt.Errorf("%w: key %d", ErrDuplicateKey, i)
+		}
+
+		if i == 0 {
+			k.active = id
+		}
+
+		k.keys[string(id)] = aead
+	}
+
+	return k, nil
+}
+
+// Seal encrypts value with the active key and binds it to name, Open rejects it for any other name.
+func (k *Keyring) Seal(name, value string) (string, error) {
+	aead := k.keys[string(k.active)]
+
+	nonce := make([]byte, aead.NonceSize())
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("failed read full: %w", err)
+	}
+
+	sealed := append(append([]byte{}, k.active...), nonce...)
+	sealed = aead.Seal(sealed, nonce, []byte(name+":"+value), k.active)
+
+	return base64.URLEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value produced by Seal for the same name with any key in the keyring.
+func (k *Keyring) Open(name, sealed string) (string, error) {
+	payload, err := base64.URLEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidValue, err) //nolint:errorlint
+	}
+
+	var plaintext []byte
+
+	if len(payload) >= keyIDLength {
+		if aead, ok := k.keys[string(payload[:keyIDLength])]; ok {
+			// The nonce of a legacy value can start with a key ID by chance, so a failure falls through to openLegacy.
+			plaintext, _ = open(aead, payload[keyIDLength:], payload[:keyIDLength])
+		}
+	}
+
+	if plaintext == nil {
+		if plaintext, err = k.openLegacy(payload); err != nil {
+			return "", err
+		}
+	}
+
+	sealedName, value, ok := strings.Cut(string(plaintext), ":")
+	if !ok {
+		return "", ErrInvalidValue
+	}
+
+	if sealedName != name {
+		return "", ErrNameMismatch
+	}
+
+	return value, nil
+}
+
+// openLegacy opens values sealed before key IDs were added, they carry no key ID so every key is tried.
+func (k *Keyring) openLegacy(payload []byte) ([]byte, error) {
+	for _, aead := range k.keys {
+		if plaintext, err := open(aead, payload, nil); err == nil {
+			return plaintext, nil
+		}
+	}
+
+	return nil, ErrInvalidValue
+}
+
+func open(aead cipher.AEAD, payload, additionalData []byte) ([]byte, error) {
+	if len(payload) < aead.NonceSize() {
+		return nil, ErrInvalidValue
+	}
+
+	plaintext, err := aead.Open(nil, payload[:aead.NonceSize()], payload[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, ErrInvalidValue
+	}
+
+	return plaintext, nil
+}
+
+func keyID(key []byte) []byte {
+	digest := sha256.Sum256(key)
+
+	return digest[:keyIDLength]
+}
@@ -0,0 +1,133 @@
+package keyring_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/shared/keyring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	name  = "core_refresh_token"
+	value = "AYNSWD44H2JKZAOHE3HF2BUK34"
+)
+
+var (
+	oldKey = []byte("0123456789abcdef0123456789abcdef")
+	newKey = []byte("fedcba9876543210fedcba9876543210")
+)
+
+func newKeyring(t *testing.T, active []byte, previous ...[]byte) *keyring.Keyring {
+	t.Helper()
+
+	k, err := keyring.New(active, previous...)
+	require.NoError(t, err)
+
+	return k
+}
+
+func TestKeyring_SealOpen(t *testing.T) {
+	k := newKeyring(t, newKey)
+
+	sealed, err := k.Seal(name, value)
+	require.NoError(t, err)
+
+	opened, err := k.Open(name, sealed)
+	require.NoError(t, err)
+
+	assert.Equal(t, value, opened)
+}
+
+func TestKeyring_Rotation(t *testing.T) {
+	sealed, err := newKeyring(t, oldKey).Seal(name, value)
+	require.NoError(t, err)
+
+	opened, err := newKeyring(t, newKey, oldKey).Open(name, sealed)
+	require.NoError(t, err)
+
+	assert.Equal(t, value, opened)
+
+	_, err = newKeyring(t, newKey).Open(name, sealed)
+	assert.ErrorIs(t, err, keyring.ErrInvalidValue)
+}
+
+func TestKeyring_NameMismatch(t *testing.T) {
+	k := newKeyring(t, newKey)
+
+	sealed, err := k.Seal("other", value)
+	require.NoError(t, err)
+
+	_, err = k.Open(name, sealed)
+	assert.ErrorIs(t, err, keyring.ErrNameMismatch)
+}
+
+func TestKeyring_Tampered(t *testing.T) {
+	k := newKeyring(t, newKey)
+
+	sealed, err := k.Seal(name, value)
+	require.NoError(t, err)
+
+	payload, _ := base64.URLEncoding.DecodeString(sealed)
+	payload[len(payload)-1] ^= 1
+
+	_, err = k.Open(name, base64.URLEncoding.EncodeToString(payload))
+	assert.ErrorIs(t, err, keyring.ErrInvalidValue)
+
+	_, err = k.Open(name, "not base64!")
+	assert.ErrorIs(t, err, keyring.ErrInvalidValue)
+}
+
+func TestKeyring_Legacy(t *testing.T) {
+	block, err := aes.NewCipher(oldKey)
+	require.NoError(t, err)
+
+	aesGCM, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	nonce := make([]byte, aesGCM.NonceSize())
+	sealed := base64.URLEncoding.EncodeToString(aesGCM.Seal(nonce, nonce, []byte(name+":"+value), nil))
+
+	opened, err := newKeyring(t, newKey, oldKey).Open(name, sealed)
+	require.NoError(t, err)
+
+	assert.Equal(t, value, opened)
+}
+
+func TestKeyring_LegacyNonceStartsWithKeyID(t *testing.T) {
+	block, err := aes.NewCipher(newKey)
+	require.NoError(t, err)
+
+	aesGCM, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	// A legacy nonce is random, this one starts with the ID of the key that sealed it.
+	digest := sha256.Sum256(newKey)
+	nonce := make([]byte, aesGCM.NonceSize())
+	copy(nonce, digest[:4])
+
+	sealed := base64.URLEncoding.EncodeToString(aesGCM.Seal(nonce, nonce, []byte(name+":"+value), nil))
+
+	opened, err := newKeyring(t, newKey).Open(name, sealed)
+	require.NoError(t, err)
+
+	assert.Equal(t, value, opened)
+}
+
+func TestNew_Errors(t *testing.T) {
+	_, err := keyring.New(nil)
+	assert.ErrorIs(t, err, keyring.ErrNoKey)
+
+	_, err = keyring.New(newKey, oldKey, newKey)
+	assert.ErrorIs(t, err, keyring.ErrDuplicateKey)
+
+	var sizeErr aes.KeySizeError
+
+	_, err = keyring.New([]byte("short"))
+	assert.True(t, errors.As(err, &sizeErr))
+}
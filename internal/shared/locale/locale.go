@@ -0,0 +1,153 @@
+// Package locale handles the language preference of users. Locales are BCP 47 tags such as "en" or "pt-BR", anything
+// without a translation falls back to its parent language and then to Default.
+package locale
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+const Default = "en"
+
+var ErrInvalidLocale = errors.New("invalid locale")
+
+// words are what the formatting helpers need of a language, {month} in dateLayout is replaced by the month name.
+type words struct {
+	months                                  [12]string
+	dateLayout                              string
+	minute, minutes, hour, hours, day, days string
+	lessThanAMinute                         string
+}
+
+// translations holds the words the formatting helpers need, locales without an entry use English.
+var translations = map[string]words{
+	"en": {
+		months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		dateLayout:      "{month} 2, 2006 at 15:04 MST",
+		minute:          "minute",
+		minutes:         "minutes",
+		hour:            "hour",
+		hours:           "hours",
+		day:             "day",
+		days:            "days",
+		lessThanAMinute: "less than a minute",
+	},
+	"es": {
+		months: [12]string{
+			"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+		},
+		dateLayout:      "2 de {month} de 2006, 15:04 MST",
+		minute:          "minuto",
+		minutes:         "minutos",
+		hour:            "hora",
+		hours:           "horas",
+		day:             "día",
+		days:            "días",
+		lessThanAMinute: "menos de un minuto",
+	},
+}
+
+// Parse validates a BCP 47 tag and returns it in canonical form.
+func Parse(s string) (string, error) {
+	tag, err := language.Parse(s)
+	if err != nil || tag == language.Und {
+		return "", fmt.Errorf("%w: %q", ErrInvalidLocale, s)
+	}
+
+	return tag.String(), nil
+}
+
+// FromAcceptLanguage returns the most preferred locale of an Accept-Language header, or Default when the header names
+// none. The wildcard is parsed as "mul", for multiple languages, and skipped.
+func FromAcceptLanguage(header string) string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return Default
+	}
+
+	for _, tag := range tags {
+		if tag != language.Und && tag.String() != "mul" {
+			return tag.String()
+		}
+	}
+
+	return Default
+}
+
+// Fallbacks lists the locales to try for locale, from the most to the least specific and ending with Default.
+func Fallbacks(locale string) []string {
+	var fallbacks []string
+
+	if tag, err := language.Parse(locale); err == nil {
+		for ; tag != language.Und; tag = tag.Parent() {
+			fallbacks = append(fallbacks, tag.String())
+		}
+	}
+
+	if len(fallbacks) == 0 || fallbacks[len(fallbacks)-1] != Default {
+		fallbacks = append(fallbacks, Default)
+	}
+
+	return fallbacks
+}
+
+// Plural picks one or other for n following the plural rules of locale.
+func Plural(locale string, n int, one, other string) string {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		tag = language.English
+	}
+
+	if plural.Cardinal.MatchPlural(tag, n, 0, 0, 0, 0) == plural.One {
+		return one
+	}
+
+	return other
+}
+
+// FormatDate formats t in UTC with the month name and layout of locale.
+func FormatDate(locale string, t time.Time) string {
+	w := lookup(locale)
+	t = t.UTC()
+
+	return strings.Replace(t.Format(w.dateLayout), "{month}", w.months[t.Month()-1], 1)
+}
+
+// FormatDuration formats d in its largest whole unit of days, hours or minutes, such as "3 days".
+func FormatDuration(locale string, d time.Duration) string {
+	w := lookup(locale)
+
+	unit := func(n int, one, other string) string {
+		return fmt.Sprintf("%d %s", n, Plural(locale, n, one, other))
+	}
+
+	switch day := 24 * time.Hour; {
+	case d >= day && d%day == 0:
+		return unit(int(d/day), w.day, w.days)
+	case d >= time.Hour && d%time.Hour == 0:
+		return unit(int(d/time.Hour), w.hour, w.hours)
+	case d >= time.Minute:
+		return unit(int(d/time.Minute), w.minute, w.minutes)
+	default:
+		return w.lessThanAMinute
+	}
+}
+
+func lookup(locale string) words {
+	for _, l := range Fallbacks(locale) {
+		if w, ok := translations[l]; ok {
+			return w
+		}
+	}
+
+	return translations[Default]
+}
@@ -0,0 +1,69 @@
+package locale_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/seanflannery10/core/internal/shared/locale"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	l, err := locale.Parse("pt-br")
+	assert.NoError(t, err)
+	assert.Equal(t, "pt-BR", l)
+
+	for _, invalid := range []string{"", "und", "not a locale"} {
+		_, err = locale.Parse(invalid)
+		assert.ErrorIs(t, err, locale.ErrInvalidLocale, invalid)
+	}
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	assert.Equal(t, "es-MX", locale.FromAcceptLanguage("es-MX,es;q=0.9,en;q=0.8"))
+	assert.Equal(t, "de", locale.FromAcceptLanguage("en;q=0.5, de"))
+	assert.Equal(t, locale.Default, locale.FromAcceptLanguage(""))
+	assert.Equal(t, locale.Default, locale.FromAcceptLanguage("*"))
+}
+
+func TestFallbacks(t *testing.T) {
+	assert.Equal(t, []string{"pt-BR", "pt", "en"}, locale.Fallbacks("pt-BR"))
+	assert.Equal(t, []string{"en"}, locale.Fallbacks("en"))
+	assert.Equal(t, []string{"en"}, locale.Fallbacks("invalid locale"))
+}
+
+func TestPlural(t *testing.T) {
+	assert.Equal(t, "day", locale.Plural("en", 1, "day", "days"))
+	assert.Equal(t, "days", locale.Plural("en", 0, "day", "days"))
+	assert.Equal(t, "days", locale.Plural("en", 2, "day", "days"))
+	assert.Equal(t, "jour", locale.Plural("fr", 0, "jour", "jours"))
+}
+
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2023, time.April, 25, 10, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, "April 25, 2023 at 10:30 UTC", locale.FormatDate("en", date))
+	assert.Equal(t, "25 de abril de 2023, 10:30 UTC", locale.FormatDate("es-MX", date))
+	assert.Equal(t, "April 25, 2023 at 10:30 UTC", locale.FormatDate("ja", date))
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		locale   string
+		duration time.Duration
+		expected string
+	}{
+		{"en", 72 * time.Hour, "3 days"},
+		{"en", 24 * time.Hour, "1 day"},
+		{"en", 36 * time.Hour, "36 hours"},
+		{"en", 45 * time.Minute, "45 minutes"},
+		{"en", 90 * time.Second, "1 minute"},
+		{"en", time.Second, "less than a minute"},
+		{"es", time.Hour, "1 hora"},
+		{"es", 15 * time.Minute, "15 minutos"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, locale.FormatDuration(tt.locale, tt.duration))
+	}
+}
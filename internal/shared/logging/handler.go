@@ -0,0 +1,40 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/seanflannery10/core/internal/shared/utils"
+	"golang.org/x/exp/slog"
+)
+
+var _ slog.Handler = (*ContextHandler)(nil)
+
+// ContextHandler adds the ID of the request in the context to every record, records are only tied to a request
+// when they are logged with one of the context aware functions such as slog.ErrorCtx.
+type ContextHandler struct {
+	handler slog.Handler
+}
+
+func NewContextHandler(handler slog.Handler) *ContextHandler {
+	return &ContextHandler{handler: handler}
+}
+
+func (h *ContextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *ContextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if request := utils.ContextGetRequest(ctx); request != nil {
+		r.AddAttrs(slog.String("request_id", request.ID))
+	}
+
+	return h.handler.Handle(ctx, r) //nolint:wrapcheck
+}
+
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextHandler{handler: h.handler.WithAttrs(attrs)}
+}
+
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return &ContextHandler{handler: h.handler.WithGroup(name)}
+}
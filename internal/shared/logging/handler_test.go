@@ -0,0 +1,34 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/seanflannery10/core/internal/shared/logging"
+	"github.com/seanflannery10/core/internal/shared/utils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slog"
+)
+
+func TestContextHandler(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := slog.New(logging.NewContextHandler(slog.NewJSONHandler(&buf)))
+	ctx := utils.ContextSetRequest(context.Background(), &utils.Request{ID: "test-request"})
+
+	logger.InfoCtx(ctx, "with request")
+	logger.Info("without request")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	assert.Len(t, lines, 2)
+
+	var withRequest, withoutRequest map[string]any
+
+	assert.NoError(t, json.Unmarshal(lines[0], &withRequest))
+	assert.NoError(t, json.Unmarshal(lines[1], &withoutRequest))
+
+	assert.Equal(t, "test-request", withRequest["request_id"])
+	assert.NotContains(t, withoutRequest, "request_id")
+}
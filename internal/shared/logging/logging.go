@@ -0,0 +1,99 @@
+package logging
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+var ErrUnknownFormat = errors.New("unknown log format")
+
+// New returns a logger writing records in the given format to w, records below level are discarded. Changing
+// level changes the level of the logger.
+func New(w io.Writer, format string, source bool, level *slog.LevelVar) (*slog.Logger, error) {
+	opts := slog.HandlerOptions{AddSource: source, Level: level}
+
+	var handler slog.Handler
+
+	switch format {
+	case FormatJSON:
+		handler = opts.NewJSONHandler(w)
+	case FormatText:
+		handler = opts.NewTextHandler(w)
+	default:
+		return nil, errors.Wrap(ErrUnknownFormat, format)
+	}
+
+	return slog.New(NewContextHandler(handler)), nil
+}
+
+// LevelHandler reports the current log level on GET and changes it on PUT, the request body is a JSON object such
+// as {"level": "debug"}.
+func LevelHandler(level *slog.LevelVar) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			if err := setLevel(r.Body, level); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			slog.InfoCtx(r.Context(), "log level changed", "level", level.Level().String())
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		e := jx.GetEncoder()
+		defer jx.PutEncoder(e)
+
+		e.ObjStart()
+		e.FieldStart("level")
+		e.Str(level.Level().String())
+		e.ObjEnd()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(e.Bytes())
+	})
+}
+
+func setLevel(body io.Reader, level *slog.LevelVar) error {
+	var text string
+
+	d := jx.Decode(body, 0)
+
+	err := d.ObjBytes(func(d *jx.Decoder, key []byte) error {
+		if string(key) != "level" {
+			return d.Skip() //nolint:wrapcheck
+		}
+
+		v, err := d.Str()
+		text = v
+
+		return err //nolint:wrapcheck
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed decode level")
+	}
+
+	var newLevel slog.Level
+
+	if err = newLevel.UnmarshalText([]byte(text)); err != nil {
+		return errors.Wrap(err, "failed parse level")
+	}
+
+	level.Set(newLevel)
+
+	return nil
+}
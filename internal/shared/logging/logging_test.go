@@ -0,0 +1,52 @@
+package logging_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/seanflannery10/core/internal/shared/logging"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slog"
+)
+
+func TestNew(t *testing.T) {
+	var buf bytes.Buffer
+
+	level := &slog.LevelVar{}
+	level.Set(slog.LevelWarn)
+
+	logger, err := logging.New(&buf, logging.FormatText, false, level)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger.Info("hidden")
+	logger.Warn("shown")
+
+	assert.NotContains(t, buf.String(), "hidden")
+	assert.Contains(t, buf.String(), "msg=shown")
+
+	_, err = logging.New(&buf, "xml", false, level)
+	assert.ErrorIs(t, err, logging.ErrUnknownFormat)
+}
+
+func TestLevelHandler(t *testing.T) {
+	level := &slog.LevelVar{}
+	handler := logging.LevelHandler(level)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPut, "/log-level", strings.NewReader(`{"level":"debug"}`)))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"level":"DEBUG"}`, rr.Body.String())
+	assert.Equal(t, slog.LevelDebug, level.Level())
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPut, "/log-level", strings.NewReader(`{"level":"loud"}`)))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, slog.LevelDebug, level.Level())
+}
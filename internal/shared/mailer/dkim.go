@@ -0,0 +1,89 @@
+package mailer
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/emersion/go-msgauth/dkim"
+	"github.com/go-faster/errors"
+)
+
+const headerDKIMSignature = "DKIM-Signature"
+
+var ErrInvalidDKIMKey = errors.New("invalid dkim private key")
+
+// dkimHeaders are the header fields covered by the signature, List-Unsubscribe is only signed when it is set.
+var dkimHeaders = []string{
+	"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type", "Auto-Submitted",
+}
+
+// DKIM signs outbound mail when PrivateKey is set. The public key is published as a TXT record at
+// <selector>._domainkey.<domain>, where domain is Config.Domain.
+type DKIM struct {
+	PrivateKey string `env:"DKIM_PRIVATE_KEY" redact:"true"`
+	Selector   string `env:"DKIM_SELECTOR"`
+}
+
+// ParseDKIMKey parses a PEM encoded RSA key, in PKCS #1 or PKCS #8, or an Ed25519 key in PKCS #8.
+func ParseDKIMKey(key string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block found", ErrInvalidDKIMKey)
+	}
+
+	if rsaKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return rsaKey, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDKIMKey, err) //nolint:errorlint
+	}
+
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported key type %T", ErrInvalidDKIMKey, parsed)
+	}
+
+	return signer, nil
+}
+
+// sign renders msg and returns the value of its DKIM-Signature header, relaxed canonicalization lets the signature
+// survive relays that refold headers or change trailing whitespace.
+func sign(options *dkim.SignOptions, msg *Message) (string, error) {
+	raw := new(bytes.Buffer)
+
+	if _, err := msg.WriteTo(raw); err != nil {
+		return "", err
+	}
+
+	opts := *options
+	opts.HeaderKeys = dkimHeaders
+
+	if _, ok := msg.Headers[headerListUnsubscribe]; ok {
+		opts.HeaderKeys = append(append([]string(nil), dkimHeaders...), headerListUnsubscribe)
+	}
+
+	signer, err := dkim.NewSigner(&opts)
+	if err != nil {
+		return "", fmt.Errorf("failed new dkim signer: %w", err)
+	}
+
+	if _, err = signer.Write(raw.Bytes()); err != nil {
+		_ = signer.Close()
+
+		return "", fmt.Errorf("failed dkim sign: %w", err)
+	}
+
+	if err = signer.Close(); err != nil {
+		return "", fmt.Errorf("failed dkim sign: %w", err)
+	}
+
+	signature := strings.TrimPrefix(signer.Signature(), headerDKIMSignature+": ")
+
+	return strings.TrimSuffix(signature, "\r\n"), nil
+}
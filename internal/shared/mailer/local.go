@@ -0,0 +1,174 @@
+package mailer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slog"
+)
+
+type (
+	// Memory keeps every delivered message in process, tests read them back to assert on what was sent.
+	Memory struct {
+		messages []Message
+		mu       sync.Mutex
+	}
+	dirTransport struct {
+		dir string
+	}
+	mboxTransport struct {
+		path string
+		mu   sync.Mutex
+	}
+	logTransport struct{}
+)
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Deliver(_ context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.messages = append(m.messages, *msg)
+
+	return nil
+}
+
+// Messages returns the messages delivered so far, oldest first.
+func (m *Memory) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Message(nil), m.messages...)
+}
+
+func (m *Memory) Ping(context.Context) error { return nil }
+
+func (m *Memory) Close() error { return nil }
+
+// NewDir returns a transport writing each message to its own .eml file in dir, which mail clients open directly.
+func NewDir(dir string) (Transport, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil { //nolint:gomnd
+		return nil, fmt.Errorf("failed create mail directory: %w", err)
+	}
+
+	return &dirTransport{dir: dir}, nil
+}
+
+func (t *dirTransport) Deliver(_ context.Context, msg *Message) error {
+	m, err := msg.mimeMessage()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(t.dir, time.Now().UTC().Format("20060102T150405Z")+"-*.eml")
+	if err != nil {
+		return fmt.Errorf("failed create mail file: %w", err)
+	}
+
+	if _, err = m.WriteTo(file); err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("failed write mail file: %w", err)
+	}
+
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("failed close mail file: %w", err)
+	}
+
+	return nil
+}
+
+func (t *dirTransport) Ping(context.Context) error {
+	if _, err := os.Stat(t.dir); err != nil {
+		return fmt.Errorf("failed stat mail directory: %w", err)
+	}
+
+	return nil
+}
+
+func (t *dirTransport) Close() error { return nil }
+
+// NewMbox returns a transport appending messages to the mbox file at path, in the mboxrd format: lines of the
+// message that start with "From ", after any number of ">", get one more ">".
+func NewMbox(path string) Transport {
+	return &mboxTransport{path: path}
+}
+
+func (t *mboxTransport) Deliver(_ context.Context, msg *Message) error {
+	m, err := msg.mimeMessage()
+	if err != nil {
+		return err
+	}
+
+	raw := new(bytes.Buffer)
+
+	if _, err = m.WriteTo(raw); err != nil {
+		return fmt.Errorf("failed write message: %w", err)
+	}
+
+	entry := new(bytes.Buffer)
+	fmt.Fprintf(entry, "From MAILER-DAEMON %s\n", time.Now().UTC().Format(time.ANSIC))
+
+	scanner := bufio.NewScanner(raw)
+	scanner.Buffer(nil, raw.Len()+1)
+
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.HasPrefix(strings.TrimLeft(line, ">"), "From ") {
+			line = ">" + line
+		}
+
+		entry.WriteString(line + "\n")
+	}
+
+	entry.WriteString("\n")
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	file, err := os.OpenFile(t.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gomnd
+	if err != nil {
+		return fmt.Errorf("failed open mbox: %w", err)
+	}
+
+	if _, err = entry.WriteTo(file); err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("failed write mbox: %w", err)
+	}
+
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("failed close mbox: %w", err)
+	}
+
+	return nil
+}
+
+func (t *mboxTransport) Ping(context.Context) error { return nil }
+
+func (t *mboxTransport) Close() error { return nil }
+
+// NewLog returns a transport that only logs messages. The plain body is logged in full so links and tokens can be
+// copied from the log, it is meant for local development only.
+func NewLog() Transport {
+	return logTransport{}
+}
+
+func (logTransport) Deliver(_ context.Context, msg *Message) error {
+	slog.Info("mail", "to", msg.To, "subject", msg.Subject, "body", msg.PlainBody)
+
+	return nil
+}
+
+func (logTransport) Ping(context.Context) error { return nil }
+
+func (logTransport) Close() error { return nil }
@@ -1,63 +1,197 @@
+// Package mailer renders the embedded email templates and hands the result to a Transport. SMTP delivers for real,
+// the others keep mail local for development and tests: Memory captures messages in process, dir writes one .eml file
+// per message, mbox appends to a single mailbox file and log only logs them. Every message carries a Message-ID in
+// our domain and an Auto-Submitted header, and is DKIM signed when a key is configured. Suppressed recipients,
+// addresses that hard bounced or complained, are skipped by the notification job before it calls Send.
 package mailer
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
 	"embed"
+	"encoding/hex"
 	"fmt"
-	"html/template"
+	"io"
+	"net/mail"
+	"strings"
+	"time"
 
-	"github.com/wneessen/go-mail"
+	"github.com/emersion/go-msgauth/dkim"
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/shared/metrics"
+	gomail "github.com/wneessen/go-mail"
 )
 
 //go:embed "templates"
 var templateFS embed.FS
 
+const (
+	TransportSMTP   = "smtp"
+	TransportMemory = "memory"
+	TransportDir    = "dir"
+	TransportMbox   = "mbox"
+	TransportLog    = "log"
+
+	// PlaceholderSender is the default of SMTP_SENDER, fine for local sinks but not for mail that leaves the server.
+	PlaceholderSender = "Test <no-reply@testdomain.com>"
+
+	headerAutoSubmitted   = "Auto-Submitted"
+	headerDate            = "Date"
+	headerListUnsubscribe = "List-Unsubscribe"
+	headerMessageID       = "Message-ID"
+)
+
+var (
+	ErrUnknownTransport = errors.New("unknown mail transport")
+	errPathRequired     = errors.New("MAIL_PATH is required")
+	errNoDomain         = errors.New("MAIL_DOMAIN is required when SMTP_SENDER has no domain")
+)
+
+// Transports lists every transport New accepts.
+var Transports = []string{TransportSMTP, TransportMemory, TransportDir, TransportMbox, TransportLog}
+
 type (
-	SMTP struct {
-		Host     string `env:"SMTP_HOST,default=smtp.mailtrap.io"`
-		Username string `env:"SMTP_USERNAME"`
-		Password string `env:"SMTP_PASSWORD"`
-		Sender   string `env:"SMTP_SENDER,default=Test <no-reply@testdomain.com>"`
-		Port     int    `env:"SMTP_PORT,default=25"`
-		Test     bool
-	}
-	Mailer struct {
-		client *mail.Client
-		sender string
+	// Config picks the transport, Path is the directory of the dir transport and the file of the mbox transport.
+	// Domain, the domain of Message-IDs and DKIM signatures, defaults to the domain of Sender. ListUnsubscribe is the
+	// List-Unsubscribe header, such as "<mailto:unsubscribe@example.com>", and is left out when empty.
+	Config struct {
+		Transport       string `env:"MAIL_TRANSPORT,default=smtp"`
+		Path            string `env:"MAIL_PATH"`
+		Sender          string `env:"SMTP_SENDER,default=Test <no-reply@testdomain.com>"`
+		Domain          string `env:"MAIL_DOMAIN"`
+		ListUnsubscribe string `env:"MAIL_LIST_UNSUBSCRIBE"`
+		DKIM            DKIM
+		SMTP            SMTP
+	}
+	// Mailer sends an email rendered from one of the embedded templates.
+	Mailer interface {
+		// Send renders templateFile in the variant for locale, falling back to less specific locales, and delivers it.
+		Send(ctx context.Context, recipient, locale, templateFile string, data any) error
+		// Ping checks that the transport can deliver, for the health check.
+		Ping(ctx context.Context) error
+		Close() error
+	}
+	// Transport delivers rendered messages. Deliver may be called concurrently.
+	Transport interface {
+		Deliver(ctx context.Context, msg *Message) error
+		Ping(ctx context.Context) error
+		Close() error
+	}
+	// Message is a rendered email. Headers holds the header fields beyond From, To and Subject, the MIME boundary is
+	// fixed when the message is rendered so every rendering, and so the DKIM signature, covers the same bytes.
+	Message struct {
+		Headers   map[string]string
+		From      string
+		To        string
+		Subject   string
+		PlainBody string
+		HTMLBody  string
+		boundary  string
+	}
+	templateMailer struct {
+		transport       Transport
+		templates       templateSet
+		dkim            *dkim.SignOptions
+		sender          string
+		domain          string
+		listUnsubscribe string
 	}
 )
 
-func New(smtp SMTP) (Mailer, error) {
-	tlsPolicy := mail.TLSMandatory
+// New returns a Mailer delivering through the transport chosen by cfg.
+func New(cfg Config) (Mailer, error) {
+	var (
+		transport Transport
+		err       error
+	)
+
+	switch cfg.Transport {
+	case TransportSMTP:
+		transport, err = NewSMTP(cfg.SMTP)
+	case TransportMemory:
+		transport = NewMemory()
+	case TransportDir, TransportMbox:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("%w for the %s transport", errPathRequired, cfg.Transport)
+		}
 
-	if smtp.Test {
-		tlsPolicy = mail.TLSOpportunistic
+		if cfg.Transport == TransportDir {
+			transport, err = NewDir(cfg.Path)
+		} else {
+			transport = NewMbox(cfg.Path)
+		}
+	case TransportLog:
+		transport = NewLog()
+	default:
+		return nil, errors.Wrap(ErrUnknownTransport, cfg.Transport)
 	}
 
-	client, err := mail.NewClient(
-		smtp.Host,
-		mail.WithPort(smtp.Port),
-		mail.WithUsername(smtp.Username),
-		mail.WithPassword(smtp.Password),
-		mail.WithSMTPAuth(mail.SMTPAuthPlain),
-		mail.WithTLSPolicy(tlsPolicy),
-	)
 	if err != nil {
-		return Mailer{}, fmt.Errorf("failed new mail client: %w", err)
+		return nil, err
 	}
 
-	mailer := Mailer{
-		client: client,
-		sender: smtp.Sender,
+	return NewWithTransport(cfg, transport)
+}
+
+// NewWithTransport returns a Mailer configured by cfg that delivers through transport instead of the one cfg names,
+// tests pass a Memory to read what was sent.
+func NewWithTransport(cfg Config, transport Transport) (Mailer, error) {
+	templates, err := parseTemplates(templateFS, "templates")
+	if err != nil {
+		return nil, err
+	}
+
+	m := &templateMailer{
+		transport:       transport,
+		templates:       templates,
+		sender:          cfg.Sender,
+		domain:          cfg.Domain,
+		listUnsubscribe: cfg.ListUnsubscribe,
+	}
+
+	if m.domain == "" {
+		if address, err := mail.ParseAddress(cfg.Sender); err == nil {
+			m.domain = address.Address[strings.LastIndex(address.Address, "@")+1:]
+		}
 	}
 
-	return mailer, nil
+	if m.domain == "" {
+		return nil, errNoDomain
+	}
+
+	if cfg.DKIM.PrivateKey != "" {
+		key, err := ParseDKIMKey(cfg.DKIM.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		m.dkim = &dkim.SignOptions{
+			Domain:                 m.domain,
+			Selector:               cfg.DKIM.Selector,
+			Signer:                 key,
+			HeaderCanonicalization: dkim.CanonicalizationRelaxed,
+			BodyCanonicalization:   dkim.CanonicalizationRelaxed,
+		}
+	}
+
+	return m, nil
 }
 
-func (m *Mailer) Send(recipient, templateFile string, data any) error {
-	tmpl, err := template.New("email").ParseFS(templateFS, "templates/"+templateFile)
+func (m *templateMailer) Send(ctx context.Context, recipient, locale, templateFile string, data any) (err error) {
+	start := time.Now()
+
+	defer func() {
+		metrics.MailerSendDuration.Observe(time.Since(start).Seconds())
+
+		if err != nil {
+			metrics.MailerSendFailures.Inc()
+		}
+	}()
+
+	tmpl, err := m.templates.lookup(locale, templateFile)
 	if err != nil {
-		return fmt.Errorf("failed read template fs: %w", err)
+		return err
 	}
 
 	subject := new(bytes.Buffer)
@@ -81,25 +215,93 @@ func (m *Mailer) Send(recipient, templateFile string, data any) error {
 		return fmt.Errorf("failed execute template html body: %w", err)
 	}
 
-	msg := mail.NewMsg()
+	id := make([]byte, 16) //nolint:gomnd
+	if _, err = rand.Read(id); err != nil {
+		return fmt.Errorf("failed generate message id: %w", err)
+	}
+
+	msg := &Message{
+		Headers: map[string]string{
+			headerAutoSubmitted: "auto-generated",
+			headerDate:          time.Now().Format(time.RFC1123Z),
+			headerMessageID:     fmt.Sprintf("<%s@%s>", hex.EncodeToString(id), m.domain),
+		},
+		From:      m.sender,
+		To:        recipient,
+		Subject:   subject.String(),
+		PlainBody: plainBody.String(),
+		HTMLBody:  htmlBody.String(),
+		boundary:  hex.EncodeToString(id),
+	}
 
-	if err = msg.To(recipient); err != nil {
-		return fmt.Errorf("failed message to: %w", err)
+	if m.listUnsubscribe != "" {
+		msg.Headers[headerListUnsubscribe] = m.listUnsubscribe
 	}
 
-	if err = msg.From(m.sender); err != nil {
-		return fmt.Errorf("failed message from: %w", err)
+	if m.dkim != nil {
+		if msg.Headers[headerDKIMSignature], err = sign(m.dkim, msg); err != nil {
+			return err
+		}
 	}
 
-	msg.Subject(subject.String())
+	if err = m.transport.Deliver(ctx, msg); err != nil {
+		return fmt.Errorf("failed deliver message: %w", err)
+	}
+
+	return nil
+}
+
+func (m *templateMailer) Ping(ctx context.Context) error {
+	return m.transport.Ping(ctx) //nolint:wrapcheck
+}
 
-	msg.SetBodyString(mail.TypeTextPlain, plainBody.String())
-	msg.SetBodyString(mail.TypeTextHTML, htmlBody.String())
+func (m *templateMailer) Close() error {
+	return m.transport.Close() //nolint:wrapcheck
+}
 
-	err = m.client.DialAndSend(msg)
+// WriteTo writes msg in the MIME format, as the SMTP, dir and mbox transports send it.
+func (msg *Message) WriteTo(w io.Writer) (int64, error) {
+	m, err := msg.mimeMessage()
 	if err != nil {
-		return fmt.Errorf("failed dial and send: %w", err)
+		return 0, err
 	}
 
-	return nil
+	n, err := m.WriteTo(w)
+	if err != nil {
+		return n, fmt.Errorf("failed write message: %w", err)
+	}
+
+	return n, nil
+}
+
+func (msg *Message) mimeMessage() (*gomail.Msg, error) {
+	m := gomail.NewMsg()
+
+	if err := m.To(msg.To); err != nil {
+		return nil, fmt.Errorf("failed message to: %w", err)
+	}
+
+	if err := m.From(msg.From); err != nil {
+		return nil, fmt.Errorf("failed message from: %w", err)
+	}
+
+	m.Subject(msg.Subject)
+
+	for header, value := range msg.Headers {
+		if header == headerDKIMSignature {
+			// The signature is already folded, encoding it again would break it.
+			m.SetGenHeaderPreformatted(gomail.Header(header), value)
+		} else {
+			m.SetGenHeader(gomail.Header(header), value)
+		}
+	}
+
+	if msg.boundary != "" {
+		m.SetBoundary(msg.boundary)
+	}
+
+	m.SetBodyString(gomail.TypeTextPlain, msg.PlainBody)
+	m.AddAlternativeString(gomail.TypeTextHTML, msg.HTMLBody)
+
+	return m, nil
 }
@@ -0,0 +1,187 @@
+package mailer_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-msgauth/dkim"
+	"github.com/seanflannery10/core/internal/shared/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sender = "Test <no-reply@testdomain.com>"
+
+func TestNew(t *testing.T) {
+	// New parses every embedded template, so a template that doesn't parse fails here rather than on first send.
+	m, err := mailer.New(mailer.Config{
+		Transport: mailer.TransportSMTP,
+		Sender:    sender,
+		SMTP:      mailer.SMTP{Host: "localhost", Port: 2525, TLS: mailer.TLSNone},
+	})
+	require.NoError(t, err)
+	assert.NoError(t, m.Close())
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	_, err := mailer.New(mailer.Config{Transport: "pigeon"})
+	assert.ErrorIs(t, err, mailer.ErrUnknownTransport)
+
+	_, err = mailer.New(mailer.Config{Transport: mailer.TransportSMTP, SMTP: mailer.SMTP{Host: "localhost", TLS: "always"}})
+	assert.ErrorIs(t, err, mailer.ErrUnknownTLSMode)
+
+	_, err = mailer.New(mailer.Config{Transport: mailer.TransportDir})
+	assert.Error(t, err)
+}
+
+func TestSend_Memory(t *testing.T) {
+	memory := mailer.NewMemory()
+
+	m, err := mailer.NewWithTransport(mailer.Config{Sender: sender}, memory)
+	require.NoError(t, err)
+
+	err = m.Send(context.Background(), "user@test.com", "es-MX", "token_activation.tmpl",
+		map[string]any{"activationToken": "ABCDEFGHIJ", "expiresIn": 3 * 24 * time.Hour})
+	require.NoError(t, err)
+
+	messages := memory.Messages()
+	require.Len(t, messages, 1)
+
+	assert.Equal(t, sender, messages[0].From)
+	assert.Equal(t, "user@test.com", messages[0].To)
+	assert.Contains(t, messages[0].PlainBody, "ABCDEFGHIJ")
+	assert.Contains(t, messages[0].PlainBody, "3 días")
+	assert.Contains(t, messages[0].HTMLBody, "ABCDEFGHIJ")
+}
+
+func TestSend_Headers(t *testing.T) {
+	memory := mailer.NewMemory()
+
+	m, err := mailer.NewWithTransport(mailer.Config{
+		Sender:          sender,
+		ListUnsubscribe: "<mailto:unsubscribe@testdomain.com>",
+	}, memory)
+	require.NoError(t, err)
+
+	err = m.Send(context.Background(), "user@test.com", "en", "user_welcome.tmpl", map[string]any{"name": "Test"})
+	require.NoError(t, err)
+
+	headers := memory.Messages()[0].Headers
+	assert.Regexp(t, `^<[0-9a-f]{32}@testdomain\.com>$`, headers["Message-ID"])
+	assert.Equal(t, "auto-generated", headers["Auto-Submitted"])
+	assert.Equal(t, "<mailto:unsubscribe@testdomain.com>", headers["List-Unsubscribe"])
+	assert.NotContains(t, headers, "DKIM-Signature")
+}
+
+func TestSend_DKIM(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	public, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	memory := mailer.NewMemory()
+
+	m, err := mailer.NewWithTransport(mailer.Config{
+		Sender:          sender,
+		Domain:          "mail.testdomain.com",
+		ListUnsubscribe: "<mailto:unsubscribe@testdomain.com>",
+		DKIM: mailer.DKIM{
+			PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+			Selector:   "core",
+		},
+	}, memory)
+	require.NoError(t, err)
+
+	err = m.Send(context.Background(), "user@test.com", "en", "user_welcome.tmpl", map[string]any{"name": "Test"})
+	require.NoError(t, err)
+
+	raw := new(bytes.Buffer)
+	_, err = memory.Messages()[0].WriteTo(raw)
+	require.NoError(t, err)
+
+	options := &dkim.VerifyOptions{LookupTXT: func(domain string) ([]string, error) {
+		assert.Equal(t, "core._domainkey.mail.testdomain.com", domain)
+
+		return []string{"v=DKIM1; k=rsa; p=" + base64.StdEncoding.EncodeToString(public)}, nil
+	}}
+
+	verifications, err := dkim.VerifyWithOptions(bytes.NewReader(raw.Bytes()), options)
+	require.NoError(t, err)
+	require.Len(t, verifications, 1)
+	assert.NoError(t, verifications[0].Err)
+	assert.Equal(t, "mail.testdomain.com", verifications[0].Domain)
+	assert.Contains(t, verifications[0].HeaderKeys, "List-Unsubscribe")
+
+	tampered := bytes.Replace(raw.Bytes(), []byte("To: <user@test.com>"), []byte("To: <attacker@test.com>"), 1)
+
+	verifications, err = dkim.VerifyWithOptions(bytes.NewReader(tampered), options)
+	require.NoError(t, err)
+	require.Len(t, verifications, 1)
+	assert.Error(t, verifications[0].Err)
+}
+
+func TestParseDKIMKey_Invalid(t *testing.T) {
+	_, err := mailer.ParseDKIMKey("not a key")
+	assert.ErrorIs(t, err, mailer.ErrInvalidDKIMKey)
+}
+
+func TestSend_TemplateNotFound(t *testing.T) {
+	memory := mailer.NewMemory()
+
+	m, err := mailer.NewWithTransport(mailer.Config{Sender: sender}, memory)
+	require.NoError(t, err)
+
+	err = m.Send(context.Background(), "user@test.com", "en", "missing.tmpl", nil)
+	assert.ErrorIs(t, err, mailer.ErrTemplateNotFound)
+	assert.Empty(t, memory.Messages())
+}
+
+func TestSend_Dir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+
+	m, err := mailer.New(mailer.Config{Transport: mailer.TransportDir, Path: dir, Sender: sender})
+	require.NoError(t, err)
+	require.NoError(t, m.Ping(context.Background()))
+
+	for i := 0; i < 2; i++ {
+		err = m.Send(context.Background(), "user@test.com", "en", "user_welcome.tmpl", map[string]any{"name": "Test"})
+		require.NoError(t, err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	raw, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "To: <user@test.com>")
+}
+
+func TestSend_Mbox(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.mbox")
+
+	m, err := mailer.New(mailer.Config{Transport: mailer.TransportMbox, Path: path, Sender: sender})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		err = m.Send(context.Background(), "user@test.com", "en", "user_welcome.tmpl", map[string]any{"name": "Test"})
+		require.NoError(t, err)
+	}
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(raw), "From MAILER-DAEMON "))
+	assert.Equal(t, 2, strings.Count(string(raw), "From MAILER-DAEMON "))
+	assert.NotContains(t, string(raw), "\r\n")
+}
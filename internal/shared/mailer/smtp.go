@@ -0,0 +1,185 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	gomail "github.com/wneessen/go-mail"
+)
+
+const (
+	// TLSStartTLS upgrades a plain connection and refuses servers without STARTTLS, usually on port 587 or 25.
+	TLSStartTLS = "starttls"
+	// TLSImplicit connects with TLS from the start, usually on port 465.
+	TLSImplicit = "implicit"
+	// TLSOpportunistic uses STARTTLS when the server offers it and sends in plain text otherwise.
+	TLSOpportunistic = "opportunistic"
+	// TLSNone never uses TLS, only for local servers such as smtp4dev.
+	TLSNone = "none"
+)
+
+var ErrUnknownTLSMode = errors.New("unknown smtp tls mode")
+
+// TLSModes lists every TLS mode NewSMTP accepts.
+var TLSModes = []string{TLSStartTLS, TLSImplicit, TLSOpportunistic, TLSNone}
+
+type (
+	// SMTP configures the SMTP transport. The connection is kept open for KeepAlive after each message so bursts of
+	// mail share one connection and handshake, a KeepAlive of zero dials for every message.
+	SMTP struct {
+		Host      string        `env:"SMTP_HOST,default=smtp.mailtrap.io"`
+		Username  string        `env:"SMTP_USERNAME"`
+		Password  string        `env:"SMTP_PASSWORD" redact:"true"`
+		TLS       string        `env:"SMTP_TLS,default=starttls"`
+		Port      int           `env:"SMTP_PORT,default=25"`
+		KeepAlive time.Duration `env:"SMTP_KEEP_ALIVE,default=30s"`
+	}
+	smtpTransport struct {
+		client    *gomail.Client
+		idle      *time.Timer
+		address   string
+		keepAlive time.Duration
+		mu        sync.Mutex
+		connected bool
+	}
+)
+
+// NewSMTP returns a transport delivering to the SMTP server of cfg, it connects on the first message.
+func NewSMTP(cfg SMTP) (Transport, error) {
+	opts := []gomail.Option{gomail.WithPort(cfg.Port)}
+
+	if cfg.Username != "" {
+		opts = append(opts,
+			gomail.WithSMTPAuth(gomail.SMTPAuthPlain),
+			gomail.WithUsername(cfg.Username),
+			gomail.WithPassword(cfg.Password),
+		)
+	}
+
+	switch cfg.TLS {
+	case TLSStartTLS:
+		opts = append(opts, gomail.WithTLSPolicy(gomail.TLSMandatory))
+	case TLSImplicit:
+		opts = append(opts, gomail.WithSSL())
+	case TLSOpportunistic:
+		opts = append(opts, gomail.WithTLSPolicy(gomail.TLSOpportunistic))
+	case TLSNone:
+		opts = append(opts, gomail.WithTLSPolicy(gomail.NoTLS))
+	default:
+		return nil, errors.Wrap(ErrUnknownTLSMode, cfg.TLS)
+	}
+
+	client, err := gomail.NewClient(cfg.Host, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed new mail client: %w", err)
+	}
+
+	return &smtpTransport{
+		client:    client,
+		address:   net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		keepAlive: cfg.KeepAlive,
+	}, nil
+}
+
+// Deliver sends msg over the open connection, dialing when there is none. A connection the server dropped while idle
+// fails its NOOP check before anything is sent, so it is redialed once and the message sent again.
+func (t *smtpTransport) Deliver(ctx context.Context, msg *Message) error {
+	m, err := msg.mimeMessage()
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.idle != nil {
+		t.idle.Stop()
+	}
+
+	err = t.send(ctx, m)
+	if isConnCheck(err) {
+		t.connected = false
+		err = t.send(ctx, m)
+	}
+
+	if err != nil || t.keepAlive <= 0 {
+		t.disconnect()
+
+		return err
+	}
+
+	t.idle = time.AfterFunc(t.keepAlive, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		t.disconnect()
+	})
+
+	return nil
+}
+
+func (t *smtpTransport) send(ctx context.Context, m *gomail.Msg) error {
+	if !t.connected {
+		if err := t.client.DialWithContext(ctx); err != nil {
+			_ = t.client.Close()
+
+			return fmt.Errorf("failed dial smtp server: %w", err)
+		}
+
+		t.connected = true
+	}
+
+	if err := t.client.Send(m); err != nil {
+		return fmt.Errorf("failed send: %w", err)
+	}
+
+	return nil
+}
+
+// disconnect quits the connection, it must be called with mu held.
+func (t *smtpTransport) disconnect() {
+	if t.connected {
+		_ = t.client.Close()
+		t.connected = false
+	}
+}
+
+// Ping checks that the SMTP server accepts connections.
+func (t *smtpTransport) Ping(ctx context.Context) error {
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "tcp", t.address)
+	if err != nil {
+		return fmt.Errorf("failed dial smtp server: %w", err)
+	}
+
+	if err = conn.Close(); err != nil {
+		return fmt.Errorf("failed close smtp connection: %w", err)
+	}
+
+	return nil
+}
+
+func (t *smtpTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.idle != nil {
+		t.idle.Stop()
+	}
+
+	t.disconnect()
+
+	return nil
+}
+
+func isConnCheck(err error) bool {
+	var sendErr *gomail.SendError
+
+	return errors.As(err, &sendErr) && sendErr.Reason == gomail.ErrConnCheck
+}
@@ -0,0 +1,147 @@
+package mailer
+
+import (
+	"fmt"
+	"html/template"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/shared/locale"
+)
+
+var (
+	ErrTemplateNotFound = errors.New("template not found")
+	errTemplateArg      = errors.New("unsupported template argument")
+)
+
+// templateSet holds every template parsed once at startup, by locale and then file name. Templates in the root of the
+// templates directory are the locale.Default variants, translations live in a directory named after their locale,
+// for example templates/es/user_welcome.tmpl.
+type templateSet map[string]map[string]*template.Template
+
+func parseTemplates(fsys fs.FS, root string) (templateSet, error) {
+	set := templateSet{}
+
+	err := fs.WalkDir(fsys, root, func(file string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(file) != ".tmpl" {
+			return err
+		}
+
+		dir, name := path.Split(strings.TrimPrefix(file, root+"/"))
+
+		l := locale.Default
+
+		if dir != "" {
+			if l, err = locale.Parse(strings.TrimSuffix(dir, "/")); err != nil {
+				return fmt.Errorf("failed parse template directory %s: %w", dir, err)
+			}
+		}
+
+		tmpl, err := template.New(name).Funcs(templateFuncs(l)).ParseFS(fsys, file)
+		if err != nil {
+			return fmt.Errorf("failed parse template %s: %w", file, err)
+		}
+
+		if set[l] == nil {
+			set[l] = map[string]*template.Template{}
+		}
+
+		set[l][name] = tmpl
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed walk templates: %w", err)
+	}
+
+	return set, nil
+}
+
+// lookup finds the most specific variant of a template for l.
+func (s templateSet) lookup(l, name string) (*template.Template, error) {
+	for _, fallback := range locale.Fallbacks(l) {
+		if tmpl, ok := s[fallback][name]; ok {
+			return tmpl, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+}
+
+// templateFuncs are the helpers available in the templates of l. Template data may have been through JSON, so they
+// accept times as RFC 3339 strings and durations as nanoseconds.
+func templateFuncs(l string) template.FuncMap {
+	return template.FuncMap{
+		"plural": func(n any, one, other string) (string, error) {
+			count, err := toInt(n)
+			if err != nil {
+				return "", err
+			}
+
+			return locale.Plural(l, count, one, other), nil
+		},
+		"formatDate": func(v any) (string, error) {
+			t, err := toTime(v)
+			if err != nil {
+				return "", err
+			}
+
+			return locale.FormatDate(l, t), nil
+		},
+		"formatDuration": func(v any) (string, error) {
+			d, err := toDuration(v)
+			if err != nil {
+				return "", err
+			}
+
+			return locale.FormatDuration(l, d), nil
+		},
+	}
+}
+
+func toInt(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int32:
+		return int(n), nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	default:
+		return 0, fmt.Errorf("%w: %T is not a number", errTemplateArg, v)
+	}
+}
+
+func toTime(v any) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case string:
+		parsed, err := time.Parse(time.RFC3339, t)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %v", errTemplateArg, err) //nolint:errorlint
+		}
+
+		return parsed, nil
+	default:
+		return time.Time{}, fmt.Errorf("%w: %T is not a time", errTemplateArg, v)
+	}
+}
+
+func toDuration(v any) (time.Duration, error) {
+	if d, ok := v.(time.Duration); ok {
+		return d, nil
+	}
+
+	n, err := toInt(v)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %T is not a duration", errTemplateArg, v)
+	}
+
+	return time.Duration(n), nil
+}
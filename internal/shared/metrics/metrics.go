@@ -0,0 +1,104 @@
+// Package metrics holds the application metrics exported to Prometheus. Label values must come from a bounded set,
+// such as ogen operation names, so the number of series stays small.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "core"
+
+var (
+	RequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of API requests by operation and status code.",
+	}, []string{"operation", "code"})
+
+	RequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of API requests by operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	MailerSendDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "mailer",
+		Name:      "send_duration_seconds",
+		Help:      "Latency of sending an email.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	})
+
+	MailerSendFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mailer",
+		Name:      "send_failures_total",
+		Help:      "Number of emails that could not be sent.",
+	})
+
+	MailerSuppressions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mailer",
+		Name:      "suppressions_total",
+		Help:      "Number of addresses added to the suppression list by reason.",
+	}, []string{"reason"})
+
+	Registrations = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "registrations_total",
+		Help:      "Number of users registered.",
+	})
+
+	Activations = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "activations_total",
+		Help:      "Number of users activated.",
+	})
+
+	Logins = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logins_total",
+		Help:      "Number of login attempts by result.",
+	}, []string{"result"})
+
+	RefreshTokenReuse = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "refresh_token_reuse_total",
+		Help:      "Number of rotated refresh tokens presented again.",
+	})
+
+	TokensPurged = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tokens_purged_total",
+		Help:      "Number of expired or used tokens deleted by scope.",
+	}, []string{"scope"})
+
+	JobsProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "jobs",
+		Name:      "processed_total",
+		Help:      "Number of job attempts by kind and result.",
+	}, []string{"kind", "result"})
+
+	JobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "jobs",
+		Name:      "duration_seconds",
+		Help:      "Duration of job attempts by kind.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"kind"})
+)
+
+const (
+	LoginFailed    = "failed"
+	LoginLocked    = "locked"
+	LoginSucceeded = "succeeded"
+
+	JobFailed    = "failed"
+	JobRetried   = "retried"
+	JobSucceeded = "succeeded"
+)
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var _ prometheus.Collector = (*PoolCollector)(nil)
+
+// PoolCollector exports the statistics of a pgxpool.Pool, they are read from the pool on every scrape.
+type PoolCollector struct {
+	pool *pgxpool.Pool
+
+	acquireCount            *prometheus.Desc
+	acquireDuration         *prometheus.Desc
+	acquiredConns           *prometheus.Desc
+	canceledAcquireCount    *prometheus.Desc
+	constructingConns       *prometheus.Desc
+	emptyAcquireCount       *prometheus.Desc
+	idleConns               *prometheus.Desc
+	maxConns                *prometheus.Desc
+	maxIdleDestroyCount     *prometheus.Desc
+	maxLifetimeDestroyCount *prometheus.Desc
+	newConnsCount           *prometheus.Desc
+	totalConns              *prometheus.Desc
+}
+
+func NewPoolCollector(pool *pgxpool.Pool) *PoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	return &PoolCollector{
+		pool:                    pool,
+		acquireCount:            desc("acquire_total", "Number of successful connection acquires."),
+		acquireDuration:         desc("acquire_duration_seconds_total", "Time spent acquiring connections."),
+		acquiredConns:           desc("acquired_connections", "Number of connections currently in use."),
+		canceledAcquireCount:    desc("canceled_acquire_total", "Number of acquires canceled by their context."),
+		constructingConns:       desc("constructing_connections", "Number of connections being established."),
+		emptyAcquireCount:       desc("empty_acquire_total", "Number of acquires that waited for a connection."),
+		idleConns:               desc("idle_connections", "Number of idle connections."),
+		maxConns:                desc("max_connections", "Maximum size of the pool."),
+		maxIdleDestroyCount:     desc("max_idle_destroy_total", "Number of connections closed for being idle too long."),
+		maxLifetimeDestroyCount: desc("max_lifetime_destroy_total", "Number of connections closed for reaching their maximum lifetime."),
+		newConnsCount:           desc("new_connections_total", "Number of connections opened."),
+		totalConns:              desc("connections", "Number of connections in the pool."),
+	}
+}
+
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.constructingConns, prometheus.GaugeValue, float64(stat.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxIdleDestroyCount, prometheus.CounterValue, float64(stat.MaxIdleDestroyCount()))
+	ch <- prometheus.MustNewConstMetric(c.maxLifetimeDestroyCount, prometheus.CounterValue, float64(stat.MaxLifetimeDestroyCount()))
+	ch <- prometheus.MustNewConstMetric(c.newConnsCount, prometheus.CounterValue, float64(stat.NewConnsCount()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+}
@@ -0,0 +1,330 @@
+// Package migrate applies dbmate style migrations, it records versions in the same schema_migrations table so
+// databases migrated by dbmate can be taken over without changes.
+package migrate
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	// lockID is the key of the advisory lock held while migrating, so replicas starting together apply each
+	// migration once.
+	lockID = 3_874_206_991
+
+	createTable = "CREATE TABLE IF NOT EXISTS schema_migrations (version varchar(128) PRIMARY KEY)"
+
+	markerUp   = "-- migrate:up"
+	markerDown = "-- migrate:down"
+	noTx       = "transaction:false"
+
+	versionLayout = "20060102150405"
+)
+
+var (
+	ErrInvalidMigration = errors.New("invalid migration")
+	ErrNoneApplied      = errors.New("no migrations applied")
+)
+
+type (
+	// Migration is a single file, Version is the timestamp prefix of its name.
+	Migration struct {
+		Version string
+		Name    string
+		Up      Section
+		Down    Section
+	}
+	Section struct {
+		SQL         string
+		Transaction bool
+	}
+	Status struct {
+		Migration
+		Applied bool
+	}
+	Migrator struct {
+		pool       *pgxpool.Pool
+		migrations []Migration
+	}
+)
+
+// New reads the migrations from the .sql files at the root of fsys.
+func New(pool *pgxpool.Pool, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{pool: pool, migrations: migrations}, nil
+}
+
+// Load parses the .sql files at the root of fsys, sorted by version.
+func Load(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed glob migrations: %w", err)
+	}
+
+	migrations := make([]Migration, 0, len(names))
+
+	for _, name := range names {
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed read migration: %w", err)
+		}
+
+		migration, err := parse(name, string(content))
+		if err != nil {
+			return nil, err
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+func parse(file, content string) (Migration, error) {
+	version, name, ok := strings.Cut(strings.TrimSuffix(file, ".sql"), "_")
+	if !ok {
+		return Migration{}, fmt.Errorf("%w: %s: expected <version>_<name>.sql", ErrInvalidMigration, file)
+	}
+
+	migration := Migration{Version: version, Name: name}
+
+	var (
+		foundUp  bool
+		up, down strings.Builder
+		body     *strings.Builder
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, markerUp):
+			foundUp, body = true, &up
+			migration.Up.Transaction = !strings.Contains(line, noTx)
+		case strings.HasPrefix(line, markerDown):
+			body = &down
+			migration.Down.Transaction = !strings.Contains(line, noTx)
+		case body != nil:
+			body.WriteString(line)
+			body.WriteByte('\n')
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Migration{}, fmt.Errorf("failed scan migration: %w", err)
+	}
+
+	if !foundUp {
+		return Migration{}, fmt.Errorf("%w: %s: missing %q", ErrInvalidMigration, file, markerUp)
+	}
+
+	migration.Up.SQL = strings.TrimSpace(up.String())
+	migration.Down.SQL = strings.TrimSpace(down.String())
+
+	return migration, nil
+}
+
+// Up applies every migration that has not been applied yet, oldest first, and returns the applied migrations.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.locked(ctx, func(conn *pgxpool.Conn, versions map[string]bool) error {
+		for _, migration := range m.migrations {
+			if versions[migration.Version] {
+				continue
+			}
+
+			record := func(q execer) error {
+				_, err := q.Exec(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", migration.Version)
+				return err //nolint:wrapcheck
+			}
+
+			if err := run(ctx, conn, migration.Up, record); err != nil {
+				return fmt.Errorf("failed apply migration %s_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			slog.Info("applied migration", "version", migration.Version, "name", migration.Name)
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down rolls back the newest applied migration.
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	var rolledBack *Migration
+
+	err := m.locked(ctx, func(conn *pgxpool.Conn, versions map[string]bool) error {
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if !versions[migration.Version] {
+				continue
+			}
+
+			record := func(q execer) error {
+				_, err := q.Exec(ctx, "DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+				return err //nolint:wrapcheck
+			}
+
+			if err := run(ctx, conn, migration.Down, record); err != nil {
+				return fmt.Errorf("failed roll back migration %s_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			slog.Info("rolled back migration", "version", migration.Version, "name", migration.Name)
+
+			rolledBack = &migration
+
+			return nil
+		}
+
+		return ErrNoneApplied
+	})
+
+	return rolledBack, err
+}
+
+// Status reports every migration and whether it has been applied.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	versions, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, len(m.migrations))
+	for i, migration := range m.migrations {
+		statuses[i] = Status{Migration: migration, Applied: versions[migration.Version]}
+	}
+
+	return statuses, nil
+}
+
+// locked runs fn on a single connection while holding the migration advisory lock.
+func (m *Migrator) locked(ctx context.Context, fn func(*pgxpool.Conn, map[string]bool) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err = conn.Exec(ctx, "SELECT pg_advisory_lock($1)", lockID); err != nil {
+		return fmt.Errorf("failed acquire migration lock: %w", err)
+	}
+
+	defer func() {
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", lockID); err != nil {
+			slog.Error("unable to release migration lock", "error", err)
+		}
+	}()
+
+	if _, err = conn.Exec(ctx, createTable); err != nil {
+		return fmt.Errorf("failed create schema_migrations: %w", err)
+	}
+
+	versions, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	return fn(conn, versions)
+}
+
+// appliedVersions only reads, a database without schema_migrations has no migrations applied.
+func appliedVersions(ctx context.Context, conn *pgxpool.Conn) (map[string]bool, error) {
+	var exists bool
+
+	if err := conn.QueryRow(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed check schema_migrations: %w", err)
+	}
+
+	if !exists {
+		return map[string]bool{}, nil
+	}
+
+	rows, err := conn.Query(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed query schema_migrations: %w", err)
+	}
+
+	list, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed collect schema_migrations: %w", err)
+	}
+
+	versions := make(map[string]bool, len(list))
+	for _, v := range list {
+		versions[v] = true
+	}
+
+	return versions, nil
+}
+
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+// run executes a section and records it, inside one transaction unless the section opted out with
+// transaction:false, as needed for statements like CREATE INDEX CONCURRENTLY.
+func run(ctx context.Context, conn *pgxpool.Conn, section Section, record func(execer) error) error {
+	if !section.Transaction {
+		if _, err := conn.Exec(ctx, section.SQL); err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		return record(conn)
+	}
+
+	return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error { //nolint:wrapcheck
+		if _, err := tx.Exec(ctx, section.SQL); err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		return record(tx)
+	})
+}
+
+// Create writes an empty migration named name to dir and returns its path.
+func Create(dir, name string, now time.Time) (string, error) {
+	path := filepath.Join(dir, fmt.Sprintf("%s_%s.sql", now.UTC().Format(versionLayout), name))
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644) //nolint:gomnd
+	if err != nil {
+		return "", fmt.Errorf("failed create migration: %w", err)
+	}
+	defer file.Close()
+
+	if _, err = fmt.Fprintf(file, "%s\n\n\n%s\n\n", markerUp, markerDown); err != nil {
+		return "", fmt.Errorf("failed write migration: %w", err)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,98 @@
+package migrate_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/seanflannery10/core/db"
+	"github.com/seanflannery10/core/internal/shared/migrate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"20230102000000_second.sql": {Data: []byte("-- migrate:up transaction:false\nCREATE INDEX CONCURRENTLY i ON t (c);\n\n-- migrate:down\nDROP INDEX i;\n")},
+		"20230101000000_first.sql":  {Data: []byte("-- migrate:up\nCREATE TABLE t (c int);\n\n-- migrate:down\nDROP TABLE t;\n")},
+		"README.md":                 {Data: []byte("not a migration")},
+	}
+
+	migrations, err := migrate.Load(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []migrate.Migration{
+		{
+			Version: "20230101000000",
+			Name:    "first",
+			Up:      migrate.Section{SQL: "CREATE TABLE t (c int);", Transaction: true},
+			Down:    migrate.Section{SQL: "DROP TABLE t;", Transaction: true},
+		},
+		{
+			Version: "20230102000000",
+			Name:    "second",
+			Up:      migrate.Section{SQL: "CREATE INDEX CONCURRENTLY i ON t (c);", Transaction: false},
+			Down:    migrate.Section{SQL: "DROP INDEX i;", Transaction: true},
+		},
+	}
+
+	assert.Equal(t, expected, migrations)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	_, err := migrate.Load(fstest.MapFS{"20230101000000_first.sql": {Data: []byte("CREATE TABLE t (c int);")}})
+	assert.ErrorIs(t, err, migrate.ErrInvalidMigration)
+
+	_, err = migrate.Load(fstest.MapFS{"first.sql": {Data: []byte("-- migrate:up\n")}})
+	assert.ErrorIs(t, err, migrate.ErrInvalidMigration)
+}
+
+func TestLoad_Embedded(t *testing.T) {
+	migrations, err := migrate.Load(db.MigrationFiles())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	latest, err := db.LatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NotEmpty(t, migrations)
+	assert.Equal(t, latest, migrations[len(migrations)-1].Version)
+
+	for _, m := range migrations {
+		assert.NotEmpty(t, m.Up.SQL, m.Name)
+		assert.NotEmpty(t, m.Down.SQL, m.Name)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2023, 4, 23, 18, 30, 15, 0, time.UTC)
+
+	path, err := migrate.Create(dir, "widgets", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, filepath.Join(dir, "20230423183015_widgets.sql"), path)
+
+	migrations, err := migrate.Load(os.DirFS(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []migrate.Migration{{
+		Version: "20230423183015",
+		Name:    "widgets",
+		Up:      migrate.Section{Transaction: true},
+		Down:    migrate.Section{Transaction: true},
+	}}, migrations)
+
+	_, err = migrate.Create(dir, "widgets", now)
+	assert.ErrorIs(t, err, os.ErrExist)
+}
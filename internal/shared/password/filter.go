@@ -0,0 +1,169 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	bitsPerWord = 64
+	filterMagic = "CPBF"
+	headerSize  = 16
+)
+
+var ErrInvalidFilter = errors.New("invalid breached password filter")
+
+// Filter is a bloom filter of breached passwords. Entries are keyed by the SHA-1 digest of the password, so filters
+// can be built from plaintext password lists as well as from the SHA-1 lists published by Have I Been Pwned.
+// A filter never misses a password it was built from but may report a small fraction of other passwords as breached.
+type Filter struct {
+	hashes uint32
+	bits   []uint64
+}
+
+// NewFilter returns an empty filter sized to hold n passwords with the given false positive rate.
+func NewFilter(n uint64, falsePositiveRate float64) *Filter {
+	if n == 0 {
+		n = 1
+	}
+
+	m := math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/float64(n)*math.Ln2))
+	words := uint64(math.Ceil(m / bitsPerWord))
+
+	return &Filter{hashes: uint32(k), bits: make([]uint64, words)}
+}
+
+// BuildFilter reads one password per line from r. Lines holding a hex SHA-1 digest, optionally followed by a
+// ":count" suffix as in the Have I Been Pwned downloads, are added as digests and every other line as plaintext.
+func BuildFilter(r io.Reader, n uint64, falsePositiveRate float64) (*Filter, error) {
+	filter := NewFilter(n, falsePositiveRate)
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		prefix, _, _ := strings.Cut(line, ":")
+
+		if digest, err := hex.DecodeString(prefix); err == nil && len(digest) == sha1.Size {
+			var d [sha1.Size]byte
+
+			copy(d[:], digest)
+			filter.AddDigest(d)
+
+			continue
+		}
+
+		filter.Add(line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed read passwords: %w", err)
+	}
+
+	return filter, nil
+}
+
+// LoadFilter reads a filter written by WriteTo from the file at path.
+func LoadFilter(path string) (*Filter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed open filter: %w", err)
+	}
+	defer file.Close()
+
+	return ReadFilter(bufio.NewReader(file))
+}
+
+// ReadFilter reads a filter written by WriteTo.
+func ReadFilter(r io.Reader) (*Filter, error) {
+	header := make([]byte, headerSize)
+
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed read filter header: %w", err)
+	}
+
+	if string(header[:4]) != filterMagic {
+		return nil, ErrInvalidFilter
+	}
+
+	hashes := binary.LittleEndian.Uint32(header[4:8])
+	words := binary.LittleEndian.Uint64(header[8:16])
+
+	if hashes == 0 || words == 0 {
+		return nil, ErrInvalidFilter
+	}
+
+	bits := make([]uint64, words)
+
+	if err := binary.Read(r, binary.LittleEndian, bits); err != nil {
+		return nil, fmt.Errorf("failed read filter: %w", err)
+	}
+
+	return &Filter{hashes: hashes, bits: bits}, nil
+}
+
+// WriteTo writes the filter to w, it implements io.WriterTo.
+func (f *Filter) WriteTo(w io.Writer) (int64, error) {
+	header := make([]byte, headerSize)
+
+	copy(header, filterMagic)
+	binary.LittleEndian.PutUint32(header[4:8], f.hashes)
+	binary.LittleEndian.PutUint64(header[8:16], uint64(len(f.bits)))
+
+	n, err := w.Write(header)
+	if err != nil {
+		return int64(n), fmt.Errorf("failed write filter header: %w", err)
+	}
+
+	if err = binary.Write(w, binary.LittleEndian, f.bits); err != nil {
+		return int64(n), fmt.Errorf("failed write filter: %w", err)
+	}
+
+	return int64(n + len(f.bits)*8), nil //nolint:gomnd
+}
+
+func (f *Filter) Add(password string) {
+	f.AddDigest(sha1.Sum([]byte(password))) //nolint:gosec
+}
+
+func (f *Filter) AddDigest(digest [sha1.Size]byte) {
+	size := uint64(len(f.bits)) * bitsPerWord
+	h1, h2 := splitDigest(digest)
+
+	for i := uint64(0); i < uint64(f.hashes); i++ {
+		bit := (h1 + i*h2) % size
+		f.bits[bit/bitsPerWord] |= 1 << (bit % bitsPerWord)
+	}
+}
+
+func (f *Filter) Contains(password string) bool {
+	size := uint64(len(f.bits)) * bitsPerWord
+	h1, h2 := splitDigest(sha1.Sum([]byte(password))) //nolint:gosec
+
+	for i := uint64(0); i < uint64(f.hashes); i++ {
+		bit := (h1 + i*h2) % size
+		if f.bits[bit/bitsPerWord]&(1<<(bit%bitsPerWord)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitDigest derives the two hashes used for double hashing, h2 is odd so it never degenerates to a single bit.
+func splitDigest(digest [sha1.Size]byte) (h1, h2 uint64) {
+	return binary.LittleEndian.Uint64(digest[0:8]), binary.LittleEndian.Uint64(digest[8:16]) | 1
+}
@@ -0,0 +1,151 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	argon2idPrefix = "$argon2id$"
+	phcParts       = 6
+)
+
+var (
+	ErrMismatchedHash = errors.New("password does not match hash")
+	ErrUnknownHash    = errors.New("unknown password hash format")
+
+	// DefaultArgon2id follows the OWASP recommendation for argon2id.
+	DefaultArgon2id = Argon2id{Memory: 64 * 1024, Iterations: 3, Parallelism: 2, SaltLength: 16, KeyLength: 32}
+	// TestArgon2id is cheap to compute and must only be used in tests.
+	TestArgon2id = Argon2id{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+)
+
+// Hasher hashes new passwords and verifies passwords against stored hashes. NeedsRehash reports whether a hash was
+// produced by another algorithm or with other parameters, so it should be replaced after a successful Verify.
+type Hasher interface {
+	Hash(password string) ([]byte, error)
+	Verify(hash []byte, password string) error
+	NeedsRehash(hash []byte) bool
+}
+
+var _ Hasher = Argon2id{}
+
+// Argon2id hashes passwords with argon2id and encodes them in the PHC string format. It verifies bcrypt hashes as
+// well so accounts created before argon2id was introduced can still sign in and be upgraded.
+type Argon2id struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+func (a Argon2id) Hash(password string) ([]byte, error) {
+	salt := make([]byte, a.SaltLength)
+
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed read rand: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, a.Iterations, a.Memory, a.Parallelism, a.KeyLength)
+
+	hash := fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version, a.Memory, a.Iterations, a.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+
+	return []byte(hash), nil
+}
+
+func (a Argon2id) Verify(hash []byte, password string) error {
+	if !isArgon2id(hash) {
+		return verifyBcrypt(hash, password)
+	}
+
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return err
+	}
+
+	other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return ErrMismatchedHash
+	}
+
+	return nil
+}
+
+func (a Argon2id) NeedsRehash(hash []byte) bool {
+	if !isArgon2id(hash) {
+		return true
+	}
+
+	params, _, _, err := decodeArgon2id(hash)
+	if err != nil {
+		return true
+	}
+
+	return params != a
+}
+
+func isArgon2id(hash []byte) bool {
+	return strings.HasPrefix(string(hash), argon2idPrefix)
+}
+
+func decodeArgon2id(hash []byte) (params Argon2id, salt, key []byte, err error) {
+	parts := strings.Split(string(hash), "$")
+	if len(parts) != phcParts {
+		return Argon2id{}, nil, nil, ErrUnknownHash
+	}
+
+	var version int
+
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Argon2id{}, nil, nil, ErrUnknownHash
+	}
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism)
+	if err != nil {
+		return Argon2id{}, nil, nil, ErrUnknownHash
+	}
+
+	// argon2.IDKey panics on zero parameters, a stored hash is not trusted to be well formed.
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 {
+		return Argon2id{}, nil, nil, ErrUnknownHash
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return Argon2id{}, nil, nil, ErrUnknownHash
+	}
+
+	// An empty key would match the empty key derived for any password.
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(key) == 0 {
+		return Argon2id{}, nil, nil, ErrUnknownHash
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
+
+func verifyBcrypt(hash []byte, password string) error {
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil {
+		switch {
+		case errors.Is(err, bcrypt.ErrMismatchedHashAndPassword):
+			return ErrMismatchedHash
+		case errors.Is(err, bcrypt.ErrHashTooShort):
+			return ErrUnknownHash
+		default:
+			return fmt.Errorf("failed compare bcrypt hash: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package password_test
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/seanflannery10/core/internal/shared/password"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestScore(t *testing.T) {
+	assert.Equal(t, 0, password.Score("aaaaaaaa"))
+	assert.Equal(t, 0, password.Score("12345678"))
+	assert.Less(t, password.Score("testtest"), password.Score("Tr0ub4dor&3x"))
+	assert.Equal(t, password.MaxScore, password.Score("correct horse battery staple"))
+}
+
+func TestPolicy_Check(t *testing.T) {
+	breached := password.NewFilter(10, 0.001)
+	breached.Add("Winter2023!")
+
+	policy := password.Policy{MinScore: 2, BlockPersonalInfo: true, Breached: breached}
+
+	tests := []struct {
+		Password string
+		Rule     password.Rule
+	}{
+		{Password: "aaaaaaaa", Rule: password.RuleTooWeak},
+		{Password: "Winter2023!", Rule: password.RuleBreached},
+		{Password: "Sean-is-very-secure-42", Rule: password.RulePersonalInfo},
+		{Password: "flannery10-secure-42", Rule: password.RulePersonalInfo},
+		{Password: "correct horse battery staple"},
+	}
+
+	for _, tt := range tests {
+		err := policy.Check(tt.Password, "Sean Murphy", "flannery10@test.com")
+
+		if tt.Rule == "" {
+			assert.NoError(t, err, tt.Password)
+			continue
+		}
+
+		var policyErr *password.PolicyError
+		if !errors.As(err, &policyErr) {
+			t.Fatalf("expected policy error for %q, got %v", tt.Password, err)
+		}
+
+		assert.Equal(t, tt.Rule, policyErr.Rule, tt.Password)
+	}
+}
+
+func TestPolicy_CheckZeroValue(t *testing.T) {
+	assert.NoError(t, password.Policy{}.Check("aaaaaaaa", "aaaaaaaa"))
+}
+
+func TestFilter_RoundTrip(t *testing.T) {
+	digest := sha1.Sum([]byte("hunter22")) //nolint:gosec
+	list := "password1\n" + strings.ToUpper(hex.EncodeToString(digest[:])) + ":1337\n"
+
+	filter, err := password.BuildFilter(strings.NewReader(list), 2, 0.0001)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+
+	if _, err = filter.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := password.ReadFilter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, loaded.Contains("password1"))
+	assert.True(t, loaded.Contains("hunter22"))
+	assert.False(t, loaded.Contains("correct horse battery staple"))
+}
+
+func TestReadFilter_Invalid(t *testing.T) {
+	_, err := password.ReadFilter(strings.NewReader("not a filter at all"))
+	assert.ErrorIs(t, err, password.ErrInvalidFilter)
+}
+
+func TestArgon2id_HashVerify(t *testing.T) {
+	hasher := password.TestArgon2id
+
+	hash, err := hasher.Hash("correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, strings.HasPrefix(string(hash), "$argon2id$v=19$m=64,t=1,p=1$"))
+	assert.NoError(t, hasher.Verify(hash, "correct horse battery staple"))
+	assert.ErrorIs(t, hasher.Verify(hash, "wrong horse battery staple"), password.ErrMismatchedHash)
+	assert.False(t, hasher.NeedsRehash(hash))
+	assert.True(t, password.DefaultArgon2id.NeedsRehash(hash))
+}
+
+func TestArgon2id_VerifyBcrypt(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("testtest"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hasher := password.TestArgon2id
+
+	assert.NoError(t, hasher.Verify(hash, "testtest"))
+	assert.ErrorIs(t, hasher.Verify(hash, "wrongpassword"), password.ErrMismatchedHash)
+	assert.True(t, hasher.NeedsRehash(hash))
+}
+
+func TestArgon2id_VerifyMalformed(t *testing.T) {
+	err := password.TestArgon2id.Verify([]byte("$argon2id$v=19$m=64,t=1$c2FsdA$a2V5"), "testtest")
+	assert.ErrorIs(t, err, password.ErrUnknownHash)
+
+	for _, hash := range []string{
+		"$argon2id$v=19$m=0,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64,t=0,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=0$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1$c2FsdA$",
+	} {
+		err = password.TestArgon2id.Verify([]byte(hash), "testtest")
+		assert.ErrorIs(t, err, password.ErrUnknownHash, hash)
+	}
+}
@@ -0,0 +1,160 @@
+package password
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	RuleBreached     Rule = "breached"
+	RulePersonalInfo Rule = "personal_info"
+	RuleTooWeak      Rule = "too_weak"
+
+	MaxScore = 4
+
+	minPersonalInfoLength = 3
+)
+
+type (
+	Rule string
+
+	// Policy decides which passwords are acceptable, the zero value accepts every password.
+	Policy struct {
+		MinScore          int
+		BlockPersonalInfo bool
+		Breached          *Filter
+	}
+
+	// PolicyError reports the rule a password failed.
+	PolicyError struct {
+		Rule    Rule
+		Message string
+	}
+)
+
+func (e *PolicyError) Error() string {
+	return e.Message
+}
+
+// Check returns a *PolicyError when the password breaks the policy, personalInfo holds values such as the name and
+// email of the user that must not appear in their password.
+func (p Policy) Check(password string, personalInfo ...string) error {
+	if p.BlockPersonalInfo && containsPersonalInfo(password, personalInfo) {
+		return &PolicyError{Rule: RulePersonalInfo, Message: "password must not contain your name or email"}
+	}
+
+	if Score(password) < p.MinScore {
+		return &PolicyError{Rule: RuleTooWeak, Message: "password is too easy to guess"}
+	}
+
+	if p.Breached != nil && p.Breached.Contains(password) {
+		return &PolicyError{Rule: RuleBreached, Message: "password has appeared in a data breach"}
+	}
+
+	return nil
+}
+
+// Score estimates the strength of a password from 0 (trivial to guess) to MaxScore. The estimate is based on the
+// character classes used and the length of the password once repeated and sequential characters are discounted.
+func Score(password string) int {
+	const (
+		bitsWeak     = 28
+		bitsFair     = 36
+		bitsStrong   = 60
+		bitsVeryGood = 80
+	)
+
+	bits := entropy(password)
+
+	switch {
+	case bits < bitsWeak:
+		return 0
+	case bits < bitsFair:
+		return 1
+	case bits < bitsStrong:
+		return 2 //nolint:gomnd
+	case bits < bitsVeryGood:
+		return 3 //nolint:gomnd
+	default:
+		return MaxScore
+	}
+}
+
+func entropy(password string) float64 {
+	const (
+		sizeDigit  = 10
+		sizeLetter = 26
+		sizeOther  = 100
+		sizeSymbol = 33
+	)
+
+	var (
+		hasDigit, hasLower, hasUpper, hasSymbol, hasOther bool
+		effectiveLength                                   float64
+		previous                                          rune
+	)
+
+	for i, r := range password {
+		switch {
+		case r >= '0' && r <= '9':
+			hasDigit = true
+		case r >= 'a' && r <= 'z':
+			hasLower = true
+		case r >= 'A' && r <= 'Z':
+			hasUpper = true
+		case r < utf8.RuneSelf && unicode.IsPrint(r):
+			hasSymbol = true
+		default:
+			hasOther = true
+		}
+
+		// Repeated characters and runs such as "abc" or "321" add little to the strength of a password.
+		delta := r - previous
+		if i > 0 && (delta >= -1 && delta <= 1) {
+			effectiveLength += 0.25
+		} else {
+			effectiveLength++
+		}
+
+		previous = r
+	}
+
+	charset := 0
+
+	for _, v := range []struct {
+		used bool
+		size int
+	}{{hasDigit, sizeDigit}, {hasLower, sizeLetter}, {hasUpper, sizeLetter}, {hasSymbol, sizeSymbol}, {hasOther, sizeOther}} {
+		if v.used {
+			charset += v.size
+		}
+	}
+
+	if charset == 0 {
+		return 0
+	}
+
+	return effectiveLength * math.Log2(float64(charset))
+}
+
+func containsPersonalInfo(password string, personalInfo []string) bool {
+	password = strings.ToLower(password)
+
+	for _, v := range personalInfo {
+		v = strings.ToLower(v)
+
+		if local, _, ok := strings.Cut(v, "@"); ok {
+			v = local
+		}
+
+		for _, part := range append(strings.Fields(v), v) {
+			if len(part) >= minPersonalInfoLength && strings.Contains(password, part) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
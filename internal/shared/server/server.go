@@ -13,23 +13,92 @@ import (
 	"golang.org/x/exp/slog"
 )
 
-const (
-	idleTimout   = 1 * time.Minute
-	readTimeout  = 10 * time.Second
-	writeTimeout = 30 * time.Second
+// adminWriteTimeout leaves room for the 30 second CPU profiles served on the admin listener.
+const adminWriteTimeout = 2 * time.Minute
+
+// DefaultTimeouts are used when Serve is called without WithTimeouts.
+var DefaultTimeouts = Timeouts{
+	Idle:     time.Minute,
+	Read:     10 * time.Second,
+	Write:    30 * time.Second,
+	Shutdown: 5 * time.Second,
+}
+
+type (
+	Option  func(*options)
+	options struct {
+		adminAddr     string
+		adminHandler  http.Handler
+		drainDelay    time.Duration
+		drains        []func(context.Context) error
+		shutdownHooks []func()
+		timeouts      Timeouts
+	}
+	// Timeouts are applied to every listener, Shutdown bounds how long in-flight requests get to finish.
+	Timeouts struct {
+		Idle     time.Duration `env:"SERVER_IDLE_TIMEOUT,default=1m"`
+		Read     time.Duration `env:"SERVER_READ_TIMEOUT,default=10s"`
+		Write    time.Duration `env:"SERVER_WRITE_TIMEOUT,default=30s"`
+		Shutdown time.Duration `env:"SERVER_SHUTDOWN_TIMEOUT,default=5s"`
+	}
 )
 
-func Serve(port int32, routes http.Handler) error {
-	shutdownError := make(chan error)
+// WithAdminServer serves handler on a second listener at addr, it is meant for diagnostics that must not be
+// reachable on the public port. Both listeners are shut down together.
+func WithAdminServer(addr string, handler http.Handler) Option {
+	return func(o *options) {
+		o.adminAddr = addr
+		o.adminHandler = handler
+	}
+}
+
+// WithTimeouts replaces DefaultTimeouts.
+func WithTimeouts(t Timeouts) Option {
+	return func(o *options) {
+		o.timeouts = t
+	}
+}
+
+// WithShutdownHook registers a function that is called as soon as shutdown starts, before the server stops
+// accepting requests.
+func WithShutdownHook(hook func()) Option {
+	return func(o *options) {
+		o.shutdownHooks = append(o.shutdownHooks, hook)
+	}
+}
+
+// WithDrain registers a function that is called once the servers stopped, such as a background worker finishing its
+// jobs. It shares the shutdown timeout with the servers and its error is returned by Serve.
+func WithDrain(drain func(ctx context.Context) error) Option {
+	return func(o *options) {
+		o.drains = append(o.drains, drain)
+	}
+}
+
+// WithDrainDelay keeps serving requests for d after shutdown starts, which gives load balancers time to notice the
+// failing readiness check and stop routing requests to the server.
+func WithDrainDelay(d time.Duration) Option {
+	return func(o *options) {
+		o.drainDelay = d
+	}
+}
 
-	s := &http.Server{
-		Addr:         fmt.Sprintf(":%d", port),
-		Handler:      routes,
-		IdleTimeout:  idleTimout,
-		ReadTimeout:  readTimeout,
-		WriteTimeout: writeTimeout,
+func Serve(port int32, routes http.Handler, opts ...Option) error {
+	o := &options{timeouts: DefaultTimeouts}
+	for _, opt := range opts {
+		opt(o)
 	}
 
+	servers := []*http.Server{newServer(fmt.Sprintf(":%d", port), routes, o.timeouts)}
+
+	if o.adminHandler != nil {
+		admin := newServer(o.adminAddr, o.adminHandler, o.timeouts)
+		admin.WriteTimeout = adminWriteTimeout
+		servers = append(servers, admin)
+	}
+
+	shutdownError := make(chan error, 1)
+
 	go func() {
 		quit := make(chan os.Signal, 1)
 		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -37,30 +106,76 @@ func Serve(port int32, routes http.Handler) error {
 
 		slog.Info("caught signal", "signal", sig.String())
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		for _, hook := range o.shutdownHooks {
+			hook()
+		}
+
+		time.Sleep(o.drainDelay)
+
+		ctx, cancel := context.WithTimeout(context.Background(), o.timeouts.Shutdown)
 		defer cancel()
 
-		err := s.Shutdown(ctx)
-		if err != nil {
-			shutdownError <- err
+		var err error
+
+		for _, s := range servers {
+			if shutdownErr := s.Shutdown(ctx); shutdownErr != nil && err == nil {
+				err = shutdownErr
+			}
 		}
 
-		shutdownError <- nil
+		for _, drain := range o.drains {
+			if drainErr := drain(ctx); drainErr != nil && err == nil {
+				err = drainErr
+			}
+		}
+
+		shutdownError <- err
 	}()
 
-	slog.Info("starting server", "address", s.Addr)
+	serveError := make(chan error, len(servers))
+
+	for _, s := range servers {
+		go func(s *http.Server) {
+			slog.Info("starting server", "address", s.Addr)
 
-	err := s.ListenAndServe()
-	if !errors.Is(err, http.ErrServerClosed) {
-		return fmt.Errorf("failed listen and serve: %w", err)
+			err := s.ListenAndServe()
+			if !errors.Is(err, http.ErrServerClosed) {
+				serveError <- fmt.Errorf("failed listen and serve: %w", err)
+				return
+			}
+
+			serveError <- nil
+		}(s)
+	}
+
+	for range servers {
+		if err := <-serveError; err != nil {
+			for _, s := range servers {
+				_ = s.Close()
+			}
+
+			return err
+		}
 	}
 
-	err = <-shutdownError
+	err := <-shutdownError
 	if err != nil {
 		return err
 	}
 
-	slog.Info("server stopped", "address", s.Addr)
+	for _, s := range servers {
+		slog.Info("server stopped", "address", s.Addr)
+	}
 
 	return nil
 }
+
+func newServer(addr string, handler http.Handler, t Timeouts) *http.Server {
+	return &http.Server{
+		Addr:         addr,
+		Handler:      handler,
+		IdleTimeout:  t.Idle,
+		ReadTimeout:  t.Read,
+		WriteTimeout: t.Write,
+	}
+}
@@ -1,6 +1,9 @@
 package server_test
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"os"
 	"syscall"
 	"testing"
@@ -51,4 +54,131 @@ func TestServer_Run(t *testing.T) {
 			t.Fatal(err)
 		}
 	})
+
+	t.Run("ShutdownHook", func(t *testing.T) {
+		go func() {
+			time.Sleep(300 * time.Millisecond)
+
+			p, err := os.FindProcess(os.Getpid())
+			if err != nil {
+				panic(err)
+			}
+
+			err = p.Signal(syscall.SIGTERM)
+			if err != nil {
+				return
+			}
+		}()
+
+		called := false
+
+		err := server.Serve(4444, nil, server.WithShutdownHook(func() { called = true }), server.WithDrainDelay(10*time.Millisecond))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !called {
+			t.Fatal("shutdown hook not called")
+		}
+	})
+
+	t.Run("Drain", func(t *testing.T) {
+		go func() {
+			time.Sleep(300 * time.Millisecond)
+
+			p, err := os.FindProcess(os.Getpid())
+			if err != nil {
+				panic(err)
+			}
+
+			err = p.Signal(syscall.SIGTERM)
+			if err != nil {
+				return
+			}
+		}()
+
+		errDrain := errors.New("drain failed")
+
+		err := server.Serve(4444, nil, server.WithDrain(func(ctx context.Context) error {
+			if _, ok := ctx.Deadline(); !ok {
+				t.Error("drain context has no deadline")
+			}
+
+			return errDrain
+		}))
+		if !errors.Is(err, errDrain) {
+			t.Fatalf("expected drain error, got %v", err)
+		}
+	})
+
+	t.Run("AdminServer", func(t *testing.T) {
+		admin := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+
+		go func() {
+			time.Sleep(300 * time.Millisecond)
+
+			resp, err := http.Get("http://localhost:4445/") //nolint:noctx
+			if err != nil {
+				panic(err)
+			}
+
+			_ = resp.Body.Close()
+
+			if resp.StatusCode != http.StatusNoContent {
+				panic("unexpected admin status code")
+			}
+
+			p, err := os.FindProcess(os.Getpid())
+			if err != nil {
+				panic(err)
+			}
+
+			err = p.Signal(syscall.SIGTERM)
+			if err != nil {
+				return
+			}
+		}()
+
+		err := server.Serve(4444, nil, server.WithAdminServer("localhost:4445", admin))
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("ShutdownTimeout", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		slow := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { <-release })
+
+		go func() {
+			time.Sleep(300 * time.Millisecond)
+
+			go func() {
+				resp, err := http.Get("http://localhost:4444/") //nolint:noctx
+				if err == nil {
+					_ = resp.Body.Close()
+				}
+			}()
+
+			time.Sleep(100 * time.Millisecond)
+
+			p, err := os.FindProcess(os.Getpid())
+			if err != nil {
+				panic(err)
+			}
+
+			err = p.Signal(syscall.SIGTERM)
+			if err != nil {
+				return
+			}
+		}()
+
+		timeouts := server.DefaultTimeouts
+		timeouts.Shutdown = 50 * time.Millisecond
+
+		err := server.Serve(4444, slow, server.WithTimeouts(timeouts))
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected deadline exceeded, got %v", err)
+		}
+	})
 }
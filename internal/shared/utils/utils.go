@@ -9,12 +9,33 @@ import (
 )
 
 const (
-	userContextKey = contextKey("user")
+	clientContextKey  = contextKey("client")
+	requestContextKey = contextKey("request")
+	userContextKey    = contextKey("user")
 )
 
-type contextKey string
+type (
+	contextKey string
+	Client     struct {
+		IPAddress string
+		UserAgent string
+		// AcceptLanguage is the raw Accept-Language header.
+		AcceptLanguage string
+	}
+	// Request describes the request being served. It is stored by pointer so values set further down the
+	// middleware chain, such as the authenticated user, are visible to the middleware that created it.
+	Request struct {
+		ID     string
+		UserID int64
+	}
+)
 
+// ContextSetUser stores the authenticated user and records their ID on the Request in the context, if there is one.
 func ContextSetUser(ctx context.Context, user *data.User) context.Context {
+	if request := ContextGetRequest(ctx); request != nil {
+		request.UserID = user.ID
+	}
+
 	return context.WithValue(ctx, userContextKey, *user)
 }
 
@@ -22,6 +43,33 @@ func ContextSetCookieValue(ctx context.Context, s string) context.Context {
 	return context.WithValue(ctx, userContextKey, s)
 }
 
+func ContextSetClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, clientContextKey, client)
+}
+
+func ContextGetClient(ctx context.Context) Client {
+	client, ok := ctx.Value(clientContextKey).(Client)
+	if !ok {
+		return Client{}
+	}
+
+	return client
+}
+
+func ContextSetRequest(ctx context.Context, request *Request) context.Context {
+	return context.WithValue(ctx, requestContextKey, request)
+}
+
+// ContextGetRequest returns the Request in the context or nil when the context does not belong to a request.
+func ContextGetRequest(ctx context.Context) *Request {
+	request, ok := ctx.Value(requestContextKey).(*Request)
+	if !ok {
+		return nil
+	}
+
+	return request
+}
+
 func ContextGetUser(ctx context.Context) data.User {
 	user, ok := ctx.Value(userContextKey).(data.User)
 	if !ok {
@@ -31,6 +79,13 @@ func ContextGetUser(ctx context.Context) data.User {
 	return user
 }
 
+// ContextLookupUser returns the authenticated user of the request, ok is false when the request is not authenticated.
+func ContextLookupUser(ctx context.Context) (user data.User, ok bool) {
+	user, ok = ctx.Value(userContextKey).(data.User)
+
+	return user, ok
+}
+
 func ContextGetCookieValue(ctx context.Context) string {
 	cookieValue, ok := ctx.Value(userContextKey).(string)
 	if !ok {